@@ -18,27 +18,14 @@ type base struct {
 
 // newBase creates a new base instance from the provided Textract block and page information.
 func newBase(b types.Block, p *Page) base {
-	polygon := make(Polygon, len(b.Geometry.Polygon))
-	for i, p := range b.Geometry.Polygon {
-		polygon[i] = &Point{
-			x: float64(p.X),
-			y: float64(p.Y),
-		}
-	}
-
 	return base{
-		id:         aws.ToString(b.Id),
-		confidence: float64(aws.ToFloat32(b.Confidence)),
-		blockType:  b.BlockType,
-		boundingBox: &BoundingBox{
-			height: float64(b.Geometry.BoundingBox.Height),
-			left:   float64(b.Geometry.BoundingBox.Left),
-			top:    float64(b.Geometry.BoundingBox.Top),
-			width:  float64(b.Geometry.BoundingBox.Width),
-		},
-		polygon: polygon,
-		page:    p,
-		raw:     b,
+		id:          aws.ToString(b.Id),
+		confidence:  float64(aws.ToFloat32(b.Confidence)),
+		blockType:   b.BlockType,
+		boundingBox: newBoundingBox(b.Geometry.BoundingBox),
+		polygon:     newPolygon(b.Geometry.Polygon),
+		page:        p,
+		raw:         b,
 	}
 }
 
@@ -67,6 +54,29 @@ func (b *base) Polygon() Polygon {
 	return b.polygon
 }
 
+// BoundingBoxIn returns the bounding box of the block in the given unit, or nil if the dimensions of the page are unknown.
+func (b *base) BoundingBoxIn(unit Unit) *BoundingBox {
+	if b.boundingBox == nil || b.page == nil {
+		return nil
+	}
+
+	return b.boundingBox.Convert(b.page.dimensions, UnitNormalized, unit)
+}
+
+// PolygonIn returns the polygon of the block in the given unit, or nil if the dimensions of the page are unknown.
+func (b *base) PolygonIn(unit Unit) Polygon {
+	if b.page == nil {
+		return nil
+	}
+
+	return b.polygon.Convert(b.page.dimensions, UnitNormalized, unit)
+}
+
+// Orientation returns the orientation of the block derived from its polygon, or nil if the block has no polygon.
+func (b *base) Orientation() *Orientation {
+	return b.polygon.Orientation()
+}
+
 // PageNumber returns the page number associated with the block.
 func (b *base) PageNumber() int {
 	return b.page.Number()
@@ -2,6 +2,7 @@ package textractor
 
 import (
 	"strings"
+	"sync"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/textract/types"
@@ -12,6 +13,16 @@ type blockParser struct {
 	idTypeMap  map[string]types.BlockType
 	idBlockMap map[string]types.Block
 	typeIDMap  map[types.BlockType][]string
+	report     *ParseReport
+
+	// pageTypeIDMap maps the IDs of pages to the IDs of their child blocks by type
+	pageTypeIDMap map[string]map[types.BlockType][]string
+
+	// polygonOverlap assigns key-values and tables to layouts by polygon instead of bounding box overlap
+	polygonOverlap bool
+
+	// concurrency is the maximum number of pages parsed in parallel
+	concurrency int
 }
 
 // newBlockParser creates a new blockParser instance based on the provided Textract blocks.
@@ -19,8 +30,15 @@ func newBlockParser(blocks []types.Block) *blockParser {
 	idTypeMap := make(map[string]types.BlockType)
 	idBlockMap := make(map[string]types.Block)
 	typeIDMap := make(map[types.BlockType][]string)
+	report := &ParseReport{}
 
 	for _, b := range blocks {
+		// Skip invalid blocks, so that references to them are reported as dangling
+		if err := validateBlock(b); err != nil {
+			report.add(err)
+			continue
+		}
+
 		id := aws.ToString(b.Id)
 		idTypeMap[id] = b.BlockType
 		idBlockMap[id] = b
@@ -36,6 +54,7 @@ func newBlockParser(blocks []types.Block) *blockParser {
 		idTypeMap:  idTypeMap,
 		idBlockMap: idBlockMap,
 		typeIDMap:  typeIDMap,
+		report:     report,
 	}
 }
 
@@ -47,22 +66,87 @@ func (bp *blockParser) createDocument() *Document {
 	for i, id := range ids {
 		b := bp.blockByID(id)
 
-		page := &Page{
+		pages[i] = &Page{
 			id:       aws.ToString(b.Id),
 			number:   int(aws.ToInt32(b.Page)),
 			width:    float64(b.Geometry.BoundingBox.Width),
 			height:   float64(b.Geometry.BoundingBox.Height),
 			childIDs: filterRelationshipIDsByType(b, types.RelationshipTypeChild),
 		}
+	}
+
+	bp.indexPages(pages)
+
+	pageParsers := make([]*pageParser, len(pages))
+
+	parse := func(i int) {
+		pageParsers[i] = newPageParser(bp, pages[i])
+		pageParsers[i].addPageElements()
+	}
+
+	if workers := min(bp.concurrency, len(pages)); workers > 1 {
+		var wg sync.WaitGroup
+
+		indexes := make(chan int)
+
+		for w := 0; w < workers; w++ {
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+
+				for i := range indexes {
+					parse(i)
+				}
+			}()
+		}
+
+		for i := range pages {
+			indexes <- i
+		}
 
-		pageParser := newPageParser(bp, page)
-		pageParser.addPageElements()
+		close(indexes)
+		wg.Wait()
+	} else {
+		for i := range pages {
+			parse(i)
+		}
+	}
 
-		pages[i] = page
+	// Reports are merged in page order, so that they do not depend on the concurrency
+	for _, pp := range pageParsers {
+		bp.report.warnings = append(bp.report.warnings, pp.report.warnings...)
 	}
 
 	return &Document{
-		pages: pages,
+		pages:  pages,
+		report: bp.report,
+	}
+}
+
+// indexPages groups the IDs of the child blocks of the pages by page and type in a single pass over
+// all blocks, keeping the order of the blocks in the response.
+func (bp *blockParser) indexPages(pages []*Page) {
+	idPageMap := make(map[string]string)
+
+	for _, p := range pages {
+		for _, id := range p.childIDs {
+			idPageMap[id] = p.id
+		}
+	}
+
+	bp.pageTypeIDMap = make(map[string]map[types.BlockType][]string, len(pages))
+
+	for _, p := range pages {
+		bp.pageTypeIDMap[p.id] = make(map[types.BlockType][]string)
+	}
+
+	for blockType, ids := range bp.typeIDMap {
+		for _, id := range ids {
+			if pageID, ok := idPageMap[id]; ok {
+				bp.pageTypeIDMap[pageID][blockType] = append(bp.pageTypeIDMap[pageID][blockType], id)
+			}
+		}
 	}
 }
 
@@ -76,6 +160,53 @@ func (bp *blockParser) blockByID(id string) types.Block {
 	return bp.idBlockMap[id]
 }
 
+// relatedBlocks returns the blocks referenced by the relationships of the given type. References
+// to blocks that are not part of the response are skipped and added to the report.
+func (bp *blockParser) relatedBlocks(b types.Block, relationshipType types.RelationshipType, report *ParseReport) []types.Block {
+	ids := filterRelationshipIDsByType(b, relationshipType)
+	blocks := make([]types.Block, 0, len(ids))
+
+	for _, id := range ids {
+		rb, ok := bp.idBlockMap[id]
+		if !ok {
+			report.add(&DanglingBlockIDError{
+				BlockID:          aws.ToString(b.Id),
+				BlockType:        b.BlockType,
+				RelationshipType: relationshipType,
+				ReferencedID:     id,
+			})
+
+			continue
+		}
+
+		blocks = append(blocks, rb)
+	}
+
+	return blocks
+}
+
+// validateBlock checks that the block has the fields required to parse it.
+func validateBlock(b types.Block) error {
+	if b.Id == nil {
+		return &InvalidBlockError{BlockType: b.BlockType, Reason: "missing ID"}
+	}
+
+	// Query blocks have no geometry
+	if b.BlockType == types.BlockTypeQuery {
+		if b.Query == nil {
+			return &InvalidBlockError{BlockID: aws.ToString(b.Id), BlockType: b.BlockType, Reason: "missing query"}
+		}
+
+		return nil
+	}
+
+	if b.Geometry == nil || b.Geometry.BoundingBox == nil {
+		return &InvalidBlockError{BlockID: aws.ToString(b.Id), BlockType: b.BlockType, Reason: "missing geometry"}
+	}
+
+	return nil
+}
+
 // filterRelationshipIDsByType filters relationship IDs in a block based on the specified relationship type.
 func filterRelationshipIDsByType(b types.Block, relationshipType types.RelationshipType) []string {
 	var ids []string
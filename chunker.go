@@ -0,0 +1,406 @@
+package textractor
+
+import (
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/aws/aws-sdk-go-v2/service/textract/types"
+)
+
+// TokenCounter is a function that returns the number of tokens of a text.
+type TokenCounter func(text string) int
+
+// ChunkOptions defines how a document is split into chunks.
+type ChunkOptions struct {
+	// MaxCharacters is the maximum number of characters of a chunk. Zero disables the limit.
+	MaxCharacters int
+
+	// MaxTokens is the maximum number of tokens of a chunk, as counted by the TokenCounter. Zero disables the limit.
+	MaxTokens int
+
+	// TokenCounter counts the tokens of a text. Defaults to the number of whitespace separated words.
+	TokenCounter TokenCounter
+
+	// HeadingSizeTolerance is the relative difference in line height below which two section headers are considered to be on the same level.
+	HeadingSizeTolerance float64
+
+	// TextLinearizationOptions are the options used to linearize the layouts of a chunk.
+	TextLinearizationOptions TextLinearizationOptions
+}
+
+// Chunk represents a part of a document, e.g. for indexing in a retrieval-augmented generation pipeline.
+type Chunk struct {
+	index       int
+	text        string
+	headingPath []string
+	sources     []*ChunkSource
+}
+
+// Index returns the position of the chunk within the document.
+func (c *Chunk) Index() int {
+	return c.index
+}
+
+// Text returns the text of the chunk.
+func (c *Chunk) Text() string {
+	return c.text
+}
+
+// HeadingPath returns the titles and section headers the chunk is nested under, from the outermost to the innermost.
+func (c *Chunk) HeadingPath() []string {
+	return c.headingPath
+}
+
+// Sources returns the blocks the chunk was created from.
+func (c *Chunk) Sources() []*ChunkSource {
+	return c.sources
+}
+
+// BlockIDs returns the IDs of the blocks the chunk was created from.
+func (c *Chunk) BlockIDs() []string {
+	ids := make([]string, len(c.sources))
+	for i, s := range c.sources {
+		ids[i] = s.id
+	}
+
+	return ids
+}
+
+// PageNumbers returns the numbers of the pages the chunk spans.
+func (c *Chunk) PageNumbers() []int {
+	var numbers []int
+
+	for _, s := range c.sources {
+		if !slices.Contains(numbers, s.pageNumber) {
+			numbers = append(numbers, s.pageNumber)
+		}
+	}
+
+	return numbers
+}
+
+// BoundingBox returns the bounding box enclosing all sources of the chunk on the given page.
+func (c *Chunk) BoundingBox(pageNumber int) *BoundingBox {
+	var sources []*ChunkSource
+
+	for _, s := range c.sources {
+		if s.pageNumber == pageNumber && s.boundingBox != nil {
+			sources = append(sources, s)
+		}
+	}
+
+	return NewEnclosingBoundingBox(sources...)
+}
+
+// String returns the string representation of the chunk.
+func (c *Chunk) String() string {
+	return c.text
+}
+
+// ChunkSource represents a block a chunk was created from.
+type ChunkSource struct {
+	id          string
+	blockType   types.BlockType
+	pageNumber  int
+	boundingBox *BoundingBox
+}
+
+// ID returns the identifier of the source block.
+func (cs *ChunkSource) ID() string {
+	return cs.id
+}
+
+// BlockType returns the type of the source block.
+func (cs *ChunkSource) BlockType() types.BlockType {
+	return cs.blockType
+}
+
+// PageNumber returns the number of the page the source block is on.
+func (cs *ChunkSource) PageNumber() int {
+	return cs.pageNumber
+}
+
+// BoundingBox returns the bounding box of the source block.
+func (cs *ChunkSource) BoundingBox() *BoundingBox {
+	return cs.boundingBox
+}
+
+// Chunks splits the document into chunks. A new chunk is started at every title and section header,
+// and chunks exceeding the configured budgets are split between layouts, list items or lines. Tables
+// and key-value layouts are never split, so a chunk containing one of them may exceed the budgets.
+func (d *Document) Chunks(optFns ...func(*ChunkOptions)) []*Chunk {
+	opts := ChunkOptions{
+		TokenCounter:             func(text string) int { return len(strings.Fields(text)) },
+		HeadingSizeTolerance:     0.1,
+		TextLinearizationOptions: DefaultLinerizationOptions,
+	}
+
+	opts.TextLinearizationOptions.HideHeaderLayout = true
+	opts.TextLinearizationOptions.HideFooterLayout = true
+	opts.TextLinearizationOptions.HidePageNumberLayout = true
+
+	for _, fn := range optFns {
+		fn(&opts)
+	}
+
+	c := &chunker{opts: opts}
+
+	for _, p := range d.Pages() {
+		for _, l := range p.sortedLayouts(c.opts.TextLinearizationOptions.ReadingOrder) {
+			c.addLayout(l)
+		}
+	}
+
+	c.flush()
+
+	return c.chunks
+}
+
+// chunkUnit is a piece of text that is added to a chunk as a whole.
+type chunkUnit struct {
+	text    string
+	sources []*ChunkSource
+}
+
+// heading is an entry of the heading path.
+type heading struct {
+	text   string
+	height float64
+	title  bool
+}
+
+// chunker accumulates layouts into chunks.
+type chunker struct {
+	opts         ChunkOptions
+	chunks       []*Chunk
+	headings     []heading
+	units        []chunkUnit
+	characters   int // Number of characters of the current chunk
+	tokens       int // Number of tokens of the current chunk
+	onlyHeadings bool
+}
+
+// addLayout adds a layout to the current chunk, starting a new chunk if necessary.
+func (c *chunker) addLayout(l *Layout) {
+	text := strings.TrimSpace(l.Text(func(tlo *TextLinearizationOptions) {
+		*tlo = c.opts.TextLinearizationOptions
+	}))
+	if text == "" {
+		return
+	}
+
+	unit := chunkUnit{text: text, sources: []*ChunkSource{newChunkSource(&l.base)}}
+
+	switch l.BlockType() { // nolint exhaustive
+	case types.BlockTypeLayoutTitle, types.BlockTypeLayoutSectionHeader:
+		if !c.onlyHeadings {
+			c.flush()
+		}
+
+		c.pushHeading(l)
+		c.add(unit)
+
+		c.onlyHeadings = true
+
+		return
+	case types.BlockTypeLayoutTable, types.BlockTypeLayoutKeyValue:
+		c.add(unit)
+	default:
+		if c.fits(unit.text) {
+			c.add(unit)
+		} else {
+			for _, u := range c.splitLayout(l) {
+				c.add(u)
+			}
+		}
+	}
+
+	c.onlyHeadings = false
+}
+
+// add adds a unit to the current chunk, flushing the current chunk first if the unit does not fit.
+// The size of the chunk is tracked as the sum of the sizes of its units and separators.
+func (c *chunker) add(unit chunkUnit) {
+	characters, tokens := c.count(unit.text)
+
+	if len(c.units) > 0 {
+		sepCharacters, sepTokens := c.count(c.opts.TextLinearizationOptions.LayoutElementSeparator)
+
+		if !c.within(c.characters+sepCharacters+characters, c.tokens+sepTokens+tokens) {
+			c.flush()
+		} else {
+			c.characters += sepCharacters
+			c.tokens += sepTokens
+		}
+	}
+
+	c.units = append(c.units, unit)
+	c.characters += characters
+	c.tokens += tokens
+}
+
+// flush finishes the current chunk.
+func (c *chunker) flush() {
+	if len(c.units) == 0 {
+		return
+	}
+
+	headingPath := make([]string, len(c.headings))
+	for i, h := range c.headings {
+		headingPath[i] = h.text
+	}
+
+	var sources []*ChunkSource
+	for _, u := range c.units {
+		sources = append(sources, u.sources...)
+	}
+
+	c.chunks = append(c.chunks, &Chunk{
+		index:       len(c.chunks),
+		text:        c.join(c.units),
+		headingPath: headingPath,
+		sources:     sources,
+	})
+
+	c.units = nil
+	c.characters, c.tokens = 0, 0
+	c.onlyHeadings = false
+}
+
+// pushHeading updates the heading path with the given title or section header. Section headers
+// are nested under preceding section headers with a noticeably larger line height.
+func (c *chunker) pushHeading(l *Layout) {
+	h := heading{
+		text:   strings.TrimSpace(l.linearizeChildren(l.children, c.opts.TextLinearizationOptions)),
+		height: lineHeight(l),
+		title:  l.BlockType() == types.BlockTypeLayoutTitle,
+	}
+
+	if h.title {
+		c.headings = []heading{h}
+		return
+	}
+
+	for len(c.headings) > 0 {
+		top := c.headings[len(c.headings)-1]
+		if top.title || top.height > h.height*(1+c.opts.HeadingSizeTolerance) {
+			break
+		}
+
+		c.headings = c.headings[:len(c.headings)-1]
+	}
+
+	c.headings = append(c.headings, h)
+}
+
+// splitLayout splits a layout that exceeds the budgets into list items or lines, and lines into words.
+func (c *chunker) splitLayout(l *Layout) []chunkUnit {
+	var units []chunkUnit
+
+	for _, child := range l.children {
+		text := strings.TrimSpace(child.Text(func(tlo *TextLinearizationOptions) {
+			*tlo = c.opts.TextLinearizationOptions
+		}))
+		if text == "" {
+			continue
+		}
+
+		source := &ChunkSource{
+			id:          l.ID(),
+			blockType:   l.BlockType(),
+			pageNumber:  l.PageNumber(),
+			boundingBox: child.BoundingBox(),
+		}
+
+		if b, ok := child.(interface{ BlockType() types.BlockType }); ok {
+			source.id, source.blockType = child.ID(), b.BlockType()
+		}
+
+		if c.fits(text) {
+			units = append(units, chunkUnit{text: text, sources: []*ChunkSource{source}})
+			continue
+		}
+
+		var words []string
+
+		for _, w := range strings.Fields(text) {
+			if len(words) > 0 && !c.fits(strings.Join(append(words, w), " ")) {
+				units = append(units, chunkUnit{text: strings.Join(words, " "), sources: []*ChunkSource{source}})
+				words = nil
+			}
+
+			words = append(words, w)
+		}
+
+		if len(words) > 0 {
+			units = append(units, chunkUnit{text: strings.Join(words, " "), sources: []*ChunkSource{source}})
+		}
+	}
+
+	return units
+}
+
+// join joins the texts of the units.
+func (c *chunker) join(units []chunkUnit) string {
+	texts := make([]string, len(units))
+	for i, u := range units {
+		texts[i] = u.text
+	}
+
+	return strings.Join(texts, c.opts.TextLinearizationOptions.LayoutElementSeparator)
+}
+
+// fits checks if the text is within the character and token budgets.
+func (c *chunker) fits(text string) bool {
+	return c.within(c.count(text))
+}
+
+// count returns the number of characters and tokens of the text. Tokens are only counted if
+// the token budget is enabled.
+func (c *chunker) count(text string) (int, int) {
+	tokens := 0
+	if c.opts.MaxTokens > 0 {
+		tokens = c.opts.TokenCounter(text)
+	}
+
+	return utf8.RuneCountInString(text), tokens
+}
+
+// within checks if the numbers of characters and tokens are within the budgets.
+func (c *chunker) within(characters, tokens int) bool {
+	if c.opts.MaxCharacters > 0 && characters > c.opts.MaxCharacters {
+		return false
+	}
+
+	if c.opts.MaxTokens > 0 && tokens > c.opts.MaxTokens {
+		return false
+	}
+
+	return true
+}
+
+// newChunkSource creates a new ChunkSource instance from the provided block.
+func newChunkSource(b *base) *ChunkSource {
+	return &ChunkSource{
+		id:          b.ID(),
+		blockType:   b.BlockType(),
+		pageNumber:  b.PageNumber(),
+		boundingBox: b.BoundingBox(),
+	}
+}
+
+// lineHeight returns the average height of the children of the layout.
+func lineHeight(l *Layout) float64 {
+	if len(l.children) == 0 {
+		return l.BoundingBox().Height()
+	}
+
+	var sum float64
+
+	for _, c := range l.children {
+		sum += c.BoundingBox().Height()
+	}
+
+	return sum / float64(len(l.children))
+}
@@ -0,0 +1,129 @@
+package textractor
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/aws/aws-sdk-go-v2/service/textract/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChunks(t *testing.T) {
+	t.Run("Layout", func(t *testing.T) {
+		res, err := loadDocumentAPIOutputTestdata("testdata/test-layout.json")
+		assert.NoError(t, err)
+
+		doc, err := ParseDocumentAPIOutput(res)
+		assert.NoError(t, err)
+
+		chunks := doc.Chunks(func(o *ChunkOptions) {
+			o.MaxCharacters = 500
+		})
+
+		assert.Equal(t, 6, len(chunks))
+
+		for i, c := range chunks {
+			assert.Equal(t, i, c.Index())
+			assert.Equal(t, []int{0}, c.PageNumbers())
+			assert.NotNil(t, c.BoundingBox(0))
+			assert.Nil(t, c.BoundingBox(1))
+			assert.Equal(t, len(c.Sources()), len(c.BlockIDs()))
+
+			if c.Sources()[0].BlockType() != types.BlockTypeLayoutTable {
+				assert.LessOrEqual(t, utf8.RuneCountInString(c.Text()), 500)
+			}
+		}
+
+		assert.Empty(t, chunks[0].HeadingPath())
+		assert.Equal(t, []string{"Earnings Statement"}, chunks[1].HeadingPath())
+		assert.True(t, strings.HasPrefix(chunks[1].Text(), "Earnings Statement\n\n"))
+
+		// The table exceeds the budget but is not split
+		assert.Equal(t, 1, len(chunks[2].Sources()))
+		assert.Greater(t, utf8.RuneCountInString(chunks[2].Text()), 500)
+		assert.Contains(t, chunks[2].Text(), "Gross Pay")
+		assert.Contains(t, chunks[2].Text(), "Net Pay")
+	})
+
+	t.Run("HeadingPath", func(t *testing.T) {
+		page := &Page{number: 1}
+
+		page.AddLayouts(
+			newTestLayout(page, "t", types.BlockTypeLayoutTitle, 0.1, 0.04, "Title"),
+			newTestLayout(page, "s1", types.BlockTypeLayoutSectionHeader, 0.2, 0.03, "Section 1"),
+			newTestLayout(page, "p1", types.BlockTypeLayoutText, 0.3, 0.01, "Paragraph 1"),
+			newTestLayout(page, "s11", types.BlockTypeLayoutSectionHeader, 0.4, 0.02, "Section 1.1"),
+			newTestLayout(page, "p2", types.BlockTypeLayoutText, 0.5, 0.01, "Paragraph 2"),
+			newTestLayout(page, "s2", types.BlockTypeLayoutSectionHeader, 0.6, 0.03, "Section 2"),
+			newTestLayout(page, "p3", types.BlockTypeLayoutText, 0.7, 0.01, "Paragraph 3"),
+			newTestLayout(page, "f", types.BlockTypeLayoutFooter, 0.9, 0.01, "Footer"),
+		)
+
+		doc := &Document{pages: []*Page{page}}
+
+		chunks := doc.Chunks()
+
+		assert.Equal(t, 3, len(chunks))
+		assert.Equal(t, "Title\n\nSection 1\n\nParagraph 1", chunks[0].Text())
+		assert.Equal(t, []string{"Title", "Section 1"}, chunks[0].HeadingPath())
+		assert.Equal(t, []string{"t", "s1", "p1"}, chunks[0].BlockIDs())
+		assert.Equal(t, "Section 1.1\n\nParagraph 2", chunks[1].Text())
+		assert.Equal(t, []string{"Title", "Section 1", "Section 1.1"}, chunks[1].HeadingPath())
+		assert.Equal(t, "Section 2\n\nParagraph 3", chunks[2].Text())
+		assert.Equal(t, []string{"Title", "Section 2"}, chunks[2].HeadingPath())
+		assert.Equal(t, []int{1}, chunks[2].PageNumbers())
+	})
+
+	t.Run("MaxTokens", func(t *testing.T) {
+		page := &Page{number: 1}
+
+		page.AddLayouts(
+			newTestLayout(page, "p1", types.BlockTypeLayoutText, 0.1, 0.01, "one two three", "four five"),
+			newTestLayout(page, "p2", types.BlockTypeLayoutText, 0.2, 0.01, "six seven eight nine ten eleven"),
+		)
+
+		doc := &Document{pages: []*Page{page}}
+
+		chunks := doc.Chunks(func(o *ChunkOptions) {
+			o.MaxTokens = 4
+		})
+
+		texts := make([]string, len(chunks))
+		for i, c := range chunks {
+			texts[i] = c.Text()
+		}
+
+		assert.Equal(t, []string{"one two three", "four five", "six seven eight nine", "ten eleven"}, texts)
+		assert.Equal(t, []string{"p1-0"}, chunks[0].BlockIDs())
+		assert.Equal(t, types.BlockTypeLine, chunks[0].Sources()[0].BlockType())
+		assert.Equal(t, []string{"p2-0"}, chunks[3].BlockIDs())
+	})
+}
+
+func newTestLayout(page *Page, id string, blockType types.BlockType, top, lineHeight float64, lines ...string) *Layout {
+	layout := &Layout{
+		base: base{
+			id:          id,
+			blockType:   blockType,
+			boundingBox: &BoundingBox{top: top, left: 0.1, width: 0.8, height: lineHeight * float64(len(lines))},
+			page:        page,
+		},
+		noNewLines: true,
+	}
+
+	for i, text := range lines {
+		layout.AddChildren(&Line{
+			base: base{
+				id:          fmt.Sprintf("%s-%d", id, i),
+				blockType:   types.BlockTypeLine,
+				boundingBox: &BoundingBox{top: top + float64(i)*lineHeight, left: 0.1, width: 0.8, height: lineHeight},
+				page:        page,
+			},
+			words: []*Word{{text: text}},
+		})
+	}
+
+	return layout
+}
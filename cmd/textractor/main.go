@@ -0,0 +1,291 @@
+// Command textractor inspects saved Amazon Textract JSON responses offline.
+//
+// Usage:
+//
+//	textractor <command> [flags] <file>
+//
+// The file is a JSON response of AnalyzeDocument, GetDocumentAnalysis, DetectDocumentText or
+// AnalyzeID as returned by the API. Use "-" to read from standard input.
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/hupe1980/go-textractor"
+)
+
+const usage = `Usage: textractor <command> [flags] <file>
+
+Commands:
+  text        Print the linearized text of the document
+  markdown    Print the document as Markdown
+  html        Print the document as HTML
+  tables      Print the tables as CSV, Markdown or plain text
+  kv          Print the key-value pairs
+  queries     Print the queries and their answers
+  signatures  Print the detected signatures
+  id          Print the fields of an AnalyzeID response
+
+Run "textractor <command> -h" for the flags of a command.
+`
+
+func main() {
+	if err := run(os.Args[1:], os.Stdin, os.Stdout); err != nil {
+		// The usage has already been printed
+		if errors.Is(err, flag.ErrHelp) {
+			os.Exit(2)
+		}
+
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// run executes the command given by args and writes its output to w.
+func run(args []string, stdin io.Reader, w io.Writer) error {
+	if len(args) == 0 {
+		fmt.Fprint(w, usage)
+		return flag.ErrHelp
+	}
+
+	command, args := args[0], args[1:]
+
+	fs := flag.NewFlagSet(command, flag.ContinueOnError)
+	fs.SetOutput(w)
+
+	opts := textractor.DefaultLinerizationOptions
+	if command == "markdown" {
+		opts = textractor.MarkdownLinerizationOptions
+	}
+
+	var (
+		tableFormat        = "csv"
+		strict             bool
+		correctOrientation bool
+		polygonOverlap     bool
+		concurrency        int
+	)
+
+	switch command {
+	case "text", "markdown", "html", "tables", "kv", "queries", "signatures":
+		fs.BoolVar(&strict, "strict", strict, "fail on invalid blocks instead of skipping them")
+		fs.BoolVar(&correctOrientation, "correct-orientation", correctOrientation, "rotate rotated or skewed pages upright before parsing")
+		fs.BoolVar(&polygonOverlap, "polygon-overlap", polygonOverlap, "assign key-values and tables to layouts by polygon overlap")
+		fs.IntVar(&concurrency, "concurrency", concurrency, "maximum number of pages parsed in parallel")
+	}
+
+	switch command {
+	case "text", "markdown", "html":
+		registerLinearizationFlags(fs, &opts)
+	case "tables":
+		fs.StringVar(&tableFormat, "format", tableFormat, "output format: csv, markdown or plaintext")
+		fs.StringVar(&opts.TableColumnSeparator, "table-column-separator", opts.TableColumnSeparator, "column separator of plaintext tables")
+	case "kv":
+		fs.StringVar(&opts.SelectionElementSelected, "selected", opts.SelectionElementSelected, "representation of selected selection elements")
+		fs.StringVar(&opts.SelectionElementNotSelected, "not-selected", opts.SelectionElementNotSelected, "representation of unselected selection elements")
+	case "queries", "signatures", "id":
+	case "help", "-h", "-help", "--help":
+		fmt.Fprint(w, usage)
+		return nil
+	default:
+		fmt.Fprint(w, usage)
+		return fmt.Errorf("unknown command: %s", command)
+	}
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if err := opts.Validate(); err != nil {
+		return err
+	}
+
+	if fs.NArg() != 1 {
+		return fmt.Errorf("%s: expected exactly one file argument", command)
+	}
+
+	data, err := readInput(fs.Arg(0), stdin)
+	if err != nil {
+		return err
+	}
+
+	if command == "id" {
+		return printIdentityDocuments(w, data)
+	}
+
+	output := new(textractor.DocumentAPIOutput)
+	if err := json.Unmarshal(data, output); err != nil {
+		return err
+	}
+
+	doc, err := textractor.ParseDocumentAPIOutput(output, func(po *textractor.ParseOptions) {
+		po.Strict = strict
+		po.CorrectOrientation = correctOrientation
+		po.PolygonOverlap = polygonOverlap
+		po.Concurrency = concurrency
+	})
+	if err != nil {
+		return err
+	}
+
+	optFn := func(tlo *textractor.TextLinearizationOptions) {
+		*tlo = opts
+	}
+
+	switch command {
+	case "text":
+		fmt.Fprintln(w, doc.Text(optFn))
+	case "markdown":
+		fmt.Fprintln(w, doc.Markdown(optFn))
+	case "html":
+		fmt.Fprint(w, doc.HTML(optFn))
+	case "tables":
+		return printTables(w, doc, tableFormat, opts)
+	case "kv":
+		printKeyValues(w, doc, opts)
+	case "queries":
+		printQueries(w, doc)
+	case "signatures":
+		printSignatures(w, doc)
+	}
+
+	return nil
+}
+
+// registerLinearizationFlags registers flags that map to the text linearization options.
+func registerLinearizationFlags(fs *flag.FlagSet, opts *textractor.TextLinearizationOptions) {
+	fs.BoolVar(&opts.HideHeaderLayout, "hide-header", opts.HideHeaderLayout, "hide page headers")
+	fs.BoolVar(&opts.HideFooterLayout, "hide-footer", opts.HideFooterLayout, "hide page footers")
+	fs.BoolVar(&opts.HideFigureLayout, "hide-figure", opts.HideFigureLayout, "hide figures")
+	fs.BoolVar(&opts.HidePageNumberLayout, "hide-page-number", opts.HidePageNumberLayout, "hide page numbers")
+	fs.IntVar(&opts.MaxNumberOfConsecutiveNewLines, "max-new-lines", opts.MaxNumberOfConsecutiveNewLines, "maximum number of consecutive new lines")
+	fs.StringVar(&opts.TitlePrefix, "title-prefix", opts.TitlePrefix, "prefix of titles")
+	fs.StringVar(&opts.SectionHeaderPrefix, "section-header-prefix", opts.SectionHeaderPrefix, "prefix of section headers")
+	fs.StringVar(&opts.ListElementPrefix, "list-element-prefix", opts.ListElementPrefix, "prefix of list elements")
+	fs.StringVar(&opts.TableLinearizationFormat, "table-format", opts.TableLinearizationFormat, "table format: plaintext or markdown")
+	fs.StringVar(&opts.TableColumnSeparator, "table-column-separator", opts.TableColumnSeparator, "column separator of plaintext tables")
+	fs.StringVar(&opts.SelectionElementSelected, "selected", opts.SelectionElementSelected, "representation of selected selection elements")
+	fs.StringVar(&opts.SelectionElementNotSelected, "not-selected", opts.SelectionElementNotSelected, "representation of unselected selection elements")
+	fs.StringVar(&opts.SignatureToken, "signature-token", opts.SignatureToken, "representation of signatures")
+	fs.BoolVar(&opts.ShowQueries, "show-queries", opts.ShowQueries, "append query answers as \"alias: answer\" lines")
+
+	// The reading order is checked by TextLinearizationOptions.Validate
+	fs.Func("reading-order", "reading order: TOP_TO_BOTTOM, COLUMNS or NATIVE", func(s string) error {
+		opts.ReadingOrder = textractor.ReadingOrder(strings.ToUpper(s))
+		return nil
+	})
+}
+
+// readInput reads the file with the given name, or standard input if the name is "-".
+func readInput(name string, stdin io.Reader) ([]byte, error) {
+	if name == "-" {
+		return io.ReadAll(stdin)
+	}
+
+	return os.ReadFile(name)
+}
+
+func printTables(w io.Writer, doc *textractor.Document, format string, opts textractor.TextLinearizationOptions) error {
+	for i, t := range doc.Tables() {
+		if i > 0 {
+			fmt.Fprintln(w)
+		}
+
+		fmt.Fprintf(w, "# Table %d (page %d)\n", i+1, t.PageNumber())
+
+		switch format {
+		case "csv":
+			if err := t.ToCSV(w); err != nil {
+				return err
+			}
+		case "markdown", "plaintext":
+			fmt.Fprintln(w, strings.TrimRight(t.Text(func(tlo *textractor.TextLinearizationOptions) {
+				*tlo = opts
+				tlo.TableLinearizationFormat = format
+			}), "\n"))
+		default:
+			return fmt.Errorf("unknown table format: %s", format)
+		}
+	}
+
+	return nil
+}
+
+func printKeyValues(w io.Writer, doc *textractor.Document, opts textractor.TextLinearizationOptions) {
+	for _, kv := range doc.KeyValues() {
+		var key, value string
+
+		if kv.Key() != nil {
+			key = kv.Key().Text()
+		}
+
+		if kv.Value() != nil {
+			value = kv.Value().Text(func(tlo *textractor.TextLinearizationOptions) {
+				*tlo = opts
+			})
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%.2f\n", key, value, kv.Confidence())
+	}
+}
+
+func printQueries(w io.Writer, doc *textractor.Document) {
+	for _, p := range doc.Pages() {
+		for _, q := range p.Queries() {
+			name := q.Alias()
+			if name == "" {
+				name = q.Text()
+			}
+
+			answer, confidence := "", 0.0
+			if r := q.TopResult(); r != nil {
+				answer, confidence = r.Text(), r.Confidence()
+			}
+
+			fmt.Fprintf(w, "%d\t%s\t%s\t%.2f\n", p.Number(), name, answer, confidence)
+		}
+	}
+}
+
+func printSignatures(w io.Writer, doc *textractor.Document) {
+	for _, s := range doc.Signatures() {
+		fmt.Fprintf(w, "%d\t%s\t%.2f\n", s.PageNumber(), s.BoundingBox(), s.Confidence())
+	}
+}
+
+func printIdentityDocuments(w io.Writer, data []byte) error {
+	output := new(textractor.AnalyzeIDOutput)
+	if err := json.Unmarshal(data, output); err != nil {
+		return err
+	}
+
+	docs, err := textractor.ParseAnalyzeIDOutput(output)
+	if err != nil {
+		return err
+	}
+
+	for i, d := range docs {
+		if i > 0 {
+			fmt.Fprintln(w)
+		}
+
+		fmt.Fprintf(w, "# %s\n", d.IdentityDocumentType())
+
+		for _, f := range d.Fields() {
+			value := f.Value()
+			if f.IsNormalized() {
+				value = f.NormalizedValue().Value()
+			}
+
+			fmt.Fprintf(w, "%s\t%s\t%.2f\n", f.FieldType(), value, f.Confidence())
+		}
+	}
+
+	return nil
+}
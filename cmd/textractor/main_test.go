@@ -0,0 +1,94 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRun(t *testing.T) {
+	t.Run("Text", func(t *testing.T) {
+		out := &bytes.Buffer{}
+
+		err := run([]string{"text", "-selected", "[Y]", "../../testdata/test-document.json"}, nil, out)
+		assert.NoError(t, err)
+		assert.Contains(t, out.String(), "Name of package: Textractor")
+		assert.Contains(t, out.String(), "[Y]")
+	})
+
+	t.Run("Markdown", func(t *testing.T) {
+		out := &bytes.Buffer{}
+
+		err := run([]string{"markdown", "../../testdata/test-simple-table-layout.json"}, nil, out)
+		assert.NoError(t, err)
+		assert.Contains(t, out.String(), "# New Document\n\n## Paragraph 1")
+	})
+
+	t.Run("TablesCSV", func(t *testing.T) {
+		out := &bytes.Buffer{}
+
+		err := run([]string{"tables", "../../testdata/test-simple-table-layout.json"}, nil, out)
+		assert.NoError(t, err)
+		assert.Equal(t, "# Table 1 (page 0)\nA,B,C\nA1,b1,C1\nA2,B2,C2\nA3,BC3,\nA4,B4,C4\n", out.String())
+	})
+
+	t.Run("TablesMarkdown", func(t *testing.T) {
+		out := &bytes.Buffer{}
+
+		err := run([]string{"tables", "-format", "markdown", "../../testdata/test-simple-table-layout.json"}, nil, out)
+		assert.NoError(t, err)
+		assert.Contains(t, out.String(), "| A1 | b1  | C1 |")
+	})
+
+	t.Run("KeyValues", func(t *testing.T) {
+		out := &bytes.Buffer{}
+
+		err := run([]string{"kv", "../../testdata/test-document.json"}, nil, out)
+		assert.NoError(t, err)
+		assert.Contains(t, out.String(), "Date :\t08/14/2022\t72.06\n")
+		assert.Contains(t, out.String(), "Selected Checkbox\t[X]\t78.15\n")
+	})
+
+	t.Run("Signatures", func(t *testing.T) {
+		out := &bytes.Buffer{}
+
+		err := run([]string{"signatures", "../../testdata/test-response-for-llm.json"}, nil, out)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, bytes.Count(out.Bytes(), []byte("\n")))
+	})
+
+	t.Run("IdentityDocument", func(t *testing.T) {
+		out := &bytes.Buffer{}
+
+		err := run([]string{"id", "../../testdata/test-analyze-id-response.json"}, nil, out)
+		assert.NoError(t, err)
+		assert.Contains(t, out.String(), "# DRIVER LICENSE FRONT\nFIRST_NAME\tGARCIA\t98.51\n")
+	})
+
+	t.Run("Stdin", func(t *testing.T) {
+		f, err := os.Open("../../testdata/test-document.json")
+		assert.NoError(t, err)
+
+		defer f.Close()
+
+		out := &bytes.Buffer{}
+
+		err = run([]string{"text", "-"}, f, out)
+		assert.NoError(t, err)
+		assert.Contains(t, out.String(), "Textractor Test")
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		out := &bytes.Buffer{}
+
+		assert.ErrorIs(t, run(nil, nil, out), flag.ErrHelp)
+		assert.EqualError(t, run([]string{"unknown", "file.json"}, nil, out), "unknown command: unknown")
+		assert.EqualError(t, run([]string{"text"}, nil, out), "text: expected exactly one file argument")
+		assert.EqualError(t, run([]string{"text", "-reading-order", "diagonal", "file.json"}, nil, out), "unknown reading order: DIAGONAL")
+		assert.EqualError(t, run([]string{"text", "-table-format", "xml", "file.json"}, nil, out), "unknown table format: xml")
+		assert.EqualError(t, run([]string{"tables", "-format", "xml", "../../testdata/test-document.json"}, nil, out), "unknown table format: xml")
+	})
+}
@@ -0,0 +1,363 @@
+package textractor
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// decodeTag is the name of the struct tag read by Decode.
+const decodeTag = "textractor"
+
+// DecodeOptions defines how the elements of a document are matched and converted by Decode.
+type DecodeOptions struct {
+	// SearchOptions are the options used to match keys and column names.
+	SearchOptions SearchOptions
+
+	// NormalizeOptions are the options used to convert texts into dates and numbers. The Types
+	// are derived from the field types and ignored.
+	NormalizeOptions NormalizeOptions
+}
+
+// decodeSource is a text, or selection element, a field value is converted from.
+type decodeSource struct {
+	text             string
+	selectionElement *SelectionElement
+}
+
+// Decode stores the key-values, query answers and table columns of the document in the struct
+// pointed to by v. Fields are mapped by struct tags naming the source of the value:
+//
+//	type Application struct {
+//		Name      string    `textractor:"key=Full Name"`
+//		Birthday  time.Time `textractor:"query=birthday"`
+//		Employers []string  `textractor:"column=Employer Name"`
+//	}
+//
+// Keys and column names are matched with the fuzzy search, query answers by the alias or text of
+// the query. Fields of type string, bool, integer, float, time.Time and *TypedValue, pointers to these
+// types and slices of them are supported. A slice receives all matching values, e.g. every data row of
+// a column, while other fields receive the best match. Fields without tag are ignored.
+//
+// Decode returns the names of the fields for which no value was found.
+func Decode(doc *Document, v any, optFns ...func(*DecodeOptions)) ([]string, error) {
+	opts := DecodeOptions{
+		SearchOptions:    newSearchOptions(),
+		NormalizeOptions: NormalizeOptions{},
+	}
+
+	for _, fn := range optFns {
+		fn(&opts)
+	}
+
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return nil, fmt.Errorf("decode target must be a non-nil pointer to a struct, got %T", v)
+	}
+
+	rv = rv.Elem()
+	rt := rv.Type()
+
+	var unmatched []string
+
+	for i := 0; i < rt.NumField(); i++ {
+		f := rt.Field(i)
+
+		tag, ok := f.Tag.Lookup(decodeTag)
+		if !ok || tag == "-" || !f.IsExported() {
+			continue
+		}
+
+		source, name, ok := strings.Cut(tag, "=")
+		if !ok || name == "" {
+			return nil, fmt.Errorf("field %s: invalid tag %q", f.Name, tag)
+		}
+
+		var sources []decodeSource
+
+		switch source {
+		case "key":
+			sources = doc.decodeKeySources(name, opts.SearchOptions)
+		case "query":
+			sources = doc.decodeQuerySources(name)
+		case "column":
+			sources = doc.decodeColumnSources(name, opts.SearchOptions)
+		default:
+			return nil, fmt.Errorf("field %s: unknown source %q", f.Name, source)
+		}
+
+		if len(sources) == 0 {
+			unmatched = append(unmatched, f.Name)
+			continue
+		}
+
+		fv := rv.Field(i)
+
+		if fv.Kind() == reflect.Slice && fv.Type().Elem().Kind() != reflect.Uint8 {
+			slice := reflect.MakeSlice(fv.Type(), len(sources), len(sources))
+
+			for j, s := range sources {
+				if err := decodeValue(slice.Index(j), s, opts.NormalizeOptions); err != nil {
+					return nil, fmt.Errorf("field %s: %w", f.Name, err)
+				}
+			}
+
+			fv.Set(slice)
+
+			continue
+		}
+
+		if err := decodeValue(fv, sources[0], opts.NormalizeOptions); err != nil {
+			return nil, fmt.Errorf("field %s: %w", f.Name, err)
+		}
+	}
+
+	return unmatched, nil
+}
+
+// decodeKeySources returns the values of the key-value pairs whose key matches the name, ranked by similarity.
+func (d *Document) decodeKeySources(name string, opts SearchOptions) []decodeSource {
+	results := d.SearchKeys(name, func(o *SearchOptions) {
+		*o = opts
+	})
+
+	sources := make([]decodeSource, 0, len(results))
+
+	for _, r := range results {
+		if v := r.Element().Value(); v != nil {
+			sources = append(sources, decodeSource{text: v.Text(), selectionElement: v.selectionElement})
+		}
+	}
+
+	return sources
+}
+
+// decodeQuerySources returns the answers of the first query with the given alias or text on any page,
+// ranked by confidence.
+func (d *Document) decodeQuerySources(name string) []decodeSource {
+	for _, q := range d.Queries() {
+		if !strings.EqualFold(q.alias, name) && !strings.EqualFold(q.text, name) {
+			continue
+		}
+
+		results := q.ResultsByConfidence()
+		if len(results) == 0 {
+			continue
+		}
+
+		sources := make([]decodeSource, len(results))
+		for i, r := range results {
+			sources[i] = decodeSource{text: r.text}
+		}
+
+		return sources
+	}
+
+	return nil
+}
+
+// decodeColumnSources returns the cells of the best matching column of all logical tables. If a table
+// has no header rows, the first row containing a matching cell is used as header. Empty rows are skipped.
+func (d *Document) decodeColumnSources(name string, opts SearchOptions) []decodeSource {
+	query := opts.normalize(name)
+	if query == "" {
+		return nil
+	}
+
+	cellAt := func(r *LogicalTableRow, column int, ignoreMergedCells bool) Cell {
+		return r.Table().CellAt(r.Index(), column+1, func(cao *CellAtOptions) {
+			cao.IgnoreMergedCells = ignoreMergedCells
+		})
+	}
+
+	bestColumn := func(header []string) (int, float64) {
+		column, similarity := -1, 0.0
+
+		for i, h := range header {
+			if s := opts.similarity(query, h); s >= opts.MinSimilarity && s > similarity {
+				column, similarity = i, s
+			}
+		}
+
+		return column, similarity
+	}
+
+	var (
+		best           []decodeSource
+		bestSimilarity float64
+	)
+
+	for _, lt := range d.LogicalTables() {
+		rows := lt.Rows()
+		header, start := lt.Header(), 0
+
+		if header == nil {
+			for i, r := range rows {
+				texts := make([]string, lt.ColumnCount())
+				for j := range texts {
+					if c := cellAt(r, j, false); c != nil {
+						texts[j] = c.Text()
+					}
+				}
+
+				if column, _ := bestColumn(texts); column >= 0 {
+					header, start = texts, i+1
+					break
+				}
+			}
+		}
+
+		column, similarity := bestColumn(header)
+		if column < 0 || similarity <= bestSimilarity {
+			continue
+		}
+
+		var sources []decodeSource
+
+		for _, r := range rows[start:] {
+			if r.IsColumnHeader() || r.IsTableSummary() || r.IsTableSectionTitle() || strings.TrimSpace(strings.Join(r.Texts(), "")) == "" {
+				continue
+			}
+
+			var s decodeSource
+
+			// Merged cells of data rows, e.g. labels spanning several columns, are not values of the column
+			switch c := cellAt(r, column, true).(type) {
+			case *TableCell:
+				s = decodeSource{text: c.Text(), selectionElement: c.selectionElement}
+			case Cell:
+				s = decodeSource{text: c.Text()}
+			}
+
+			sources = append(sources, s)
+		}
+
+		best, bestSimilarity = sources, similarity
+	}
+
+	return best
+}
+
+var (
+	timeType       = reflect.TypeOf(time.Time{})
+	typedValueType = reflect.TypeOf((*TypedValue)(nil))
+)
+
+// decodeValue converts the source into the type of the field value and stores it.
+// Empty texts leave the field value unchanged.
+func decodeValue(fv reflect.Value, s decodeSource, opts NormalizeOptions) error {
+	text := strings.TrimSpace(s.text)
+
+	convert := func(types ...TypedValueType) *TypedValue {
+		return normalize(text, s.selectionElement, func(o *NormalizeOptions) {
+			*o = opts
+			o.Types = types
+		})
+	}
+
+	if fv.Type() == typedValueType {
+		fv.Set(reflect.ValueOf(convert(
+			TypedValueTypeCheckbox,
+			TypedValueTypeDate,
+			TypedValueTypePercentage,
+			TypedValueTypeMoney,
+			TypedValueTypeNumber,
+			TypedValueTypePhoneNumber,
+		)))
+
+		return nil
+	}
+
+	if text == "" && s.selectionElement == nil {
+		return nil
+	}
+
+	if fv.Kind() == reflect.Pointer {
+		if fv.IsNil() {
+			fv.Set(reflect.New(fv.Type().Elem()))
+		}
+
+		return decodeValue(fv.Elem(), s, opts)
+	}
+
+	if fv.Type() == timeType {
+		tv := convert(TypedValueTypeDate)
+		if tv.Type() != TypedValueTypeDate {
+			return fmt.Errorf("cannot convert %q to time.Time", text)
+		}
+
+		fv.Set(reflect.ValueOf(tv.Date()))
+
+		return nil
+	}
+
+	switch fv.Kind() {
+	case reflect.String:
+		fv.SetString(text)
+	case reflect.Bool:
+		if tv := convert(TypedValueTypeCheckbox); tv.Type() == TypedValueTypeCheckbox {
+			fv.SetBool(tv.IsChecked())
+			return nil
+		}
+
+		b, ok := parseBool(text)
+		if !ok {
+			return fmt.Errorf("cannot convert %q to bool", text)
+		}
+
+		fv.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		tv := convert(TypedValueTypeMoney, TypedValueTypePercentage, TypedValueTypeNumber)
+		if tv.Type() == TypedValueTypeText {
+			return fmt.Errorf("cannot convert %q to %s", text, fv.Type())
+		}
+
+		return setNumber(fv, tv.Number(), text)
+	default:
+		return fmt.Errorf("unsupported field type %s", fv.Type())
+	}
+
+	return nil
+}
+
+// setNumber stores the number in the integer or float field value. Integer fields require a whole number.
+func setNumber(fv reflect.Value, n float64, text string) error {
+	switch fv.Kind() {
+	case reflect.Float32, reflect.Float64:
+		if fv.OverflowFloat(n) {
+			return fmt.Errorf("cannot convert %q to %s: out of range", text, fv.Type())
+		}
+
+		fv.SetFloat(n)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if n != math.Trunc(n) || fv.OverflowInt(int64(n)) {
+			return fmt.Errorf("cannot convert %q to %s", text, fv.Type())
+		}
+
+		fv.SetInt(int64(n))
+	default:
+		if n != math.Trunc(n) || n < 0 || fv.OverflowUint(uint64(n)) {
+			return fmt.Errorf("cannot convert %q to %s", text, fv.Type())
+		}
+
+		fv.SetUint(uint64(n))
+	}
+
+	return nil
+}
+
+// boolTexts maps case-insensitive texts of yes/no answers to booleans.
+var boolTexts = map[string]bool{
+	"true": true, "yes": true, "y": true, "x": true, "1": true, "ja": true, "oui": true, "si": true,
+	"false": false, "no": false, "n": false, "0": false, "nein": false, "non": false,
+}
+
+// parseBool parses yes/no answers like "Yes", "true" or "X".
+func parseBool(text string) (bool, bool) {
+	b, ok := boolTexts[strings.ToLower(text)]
+	return b, ok
+}
@@ -0,0 +1,116 @@
+package textractor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecode(t *testing.T) {
+	t.Run("KeyValues and columns", func(t *testing.T) {
+		res, err := loadDocumentAPIOutputTestdata("testdata/test-response.json")
+		assert.NoError(t, err)
+
+		doc, err := ParseDocumentAPIOutput(res)
+		assert.NoError(t, err)
+
+		var application struct {
+			Name       string      `textractor:"key=Ful Name"`
+			Phone      *TypedValue `textractor:"key=Phone Number"`
+			Address    *string     `textractor:"key=Home Address"`
+			StartDates []time.Time `textractor:"column=Start Date"`
+			Employers  []string    `textractor:"column=Employer Name"`
+			Birthday   time.Time   `textractor:"key=Date of Birth"`
+			Ignored    string
+		}
+
+		unmatched, err := Decode(doc, &application)
+		assert.NoError(t, err)
+
+		assert.Equal(t, "Jane Doe", application.Name)
+		assert.Equal(t, TypedValueTypePhoneNumber, application.Phone.Type())
+		assert.Equal(t, "123 Any Street. Any Town. USA", *application.Address)
+		assert.Equal(t, []time.Time{
+			time.Date(2009, time.January, 15, 0, 0, 0, 0, time.UTC),
+			time.Date(2013, time.August, 15, 0, 0, 0, 0, time.UTC),
+		}, application.StartDates)
+		assert.Equal(t, []string{"Any Company", "Example Corp."}, application.Employers)
+		assert.Equal(t, []string{"Birthday"}, unmatched)
+	})
+
+	t.Run("Numbers", func(t *testing.T) {
+		res, err := loadDocumentAPIOutputTestdata("testdata/table-example-response.json")
+		assert.NoError(t, err)
+
+		doc, err := ParseDocumentAPIOutput(res)
+		assert.NoError(t, err)
+
+		var statement struct {
+			Balances []float64 `textractor:"column=Balance"`
+			Debits   []int     `textractor:"column=Debit"`
+		}
+
+		unmatched, err := Decode(doc, &statement)
+		assert.NoError(t, err)
+		assert.Empty(t, unmatched)
+
+		assert.Equal(t, []float64{11000, 10000, 9960, 10960, 10960}, statement.Balances)
+		assert.Equal(t, []int{0, 1000, 40, 0, 0}, statement.Debits)
+	})
+
+	t.Run("Queries", func(t *testing.T) {
+		doc := &Document{pages: []*Page{{queries: []*Query{
+			{alias: "TOTAL", text: "What is the total?", results: []*QueryResult{
+				{base: base{confidence: 80}, text: "$12.00"},
+				{base: base{confidence: 95}, text: "$1,234.56"},
+			}},
+			{alias: "PAID", text: "Is the invoice paid?", results: []*QueryResult{{text: "Yes"}}},
+			{alias: "DUE", text: "When is the payment due?"},
+		}}}}
+
+		var invoice struct {
+			Total float64   `textractor:"query=total"`
+			Paid  bool      `textractor:"query=Is the invoice paid?"`
+			Due   time.Time `textractor:"query=due"`
+		}
+
+		unmatched, err := Decode(doc, &invoice)
+		assert.NoError(t, err)
+
+		assert.Equal(t, 1234.56, invoice.Total)
+		assert.True(t, invoice.Paid)
+		assert.Equal(t, []string{"Due"}, unmatched)
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		doc := &Document{pages: []*Page{{queries: []*Query{
+			{alias: "total", results: []*QueryResult{{text: "twelve"}}},
+			{alias: "count", results: []*QueryResult{{text: "1.5"}}},
+		}}}}
+
+		var s struct {
+			Total float64 `textractor:"query=total"`
+		}
+
+		_, err := Decode(doc, s)
+		assert.ErrorContains(t, err, "decode target must be a non-nil pointer to a struct")
+
+		_, err = Decode(doc, &s)
+		assert.EqualError(t, err, `field Total: cannot convert "twelve" to float64`)
+
+		var i struct {
+			Count int `textractor:"query=count"`
+		}
+
+		_, err = Decode(doc, &i)
+		assert.EqualError(t, err, `field Count: cannot convert "1.5" to int`)
+
+		var tag struct {
+			Total float64 `textractor:"label=total"`
+		}
+
+		_, err = Decode(doc, &tag)
+		assert.EqualError(t, err, `field Total: unknown source "label"`)
+	})
+}
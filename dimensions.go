@@ -0,0 +1,157 @@
+package textractor
+
+import (
+	"fmt"
+	"image"
+	"io"
+)
+
+// pointsPerInch is the number of PDF points per inch.
+const pointsPerInch = 72
+
+// PageDimensions represents the real size of a page, which is needed to convert the normalized
+// coordinates of Textract into pixels of the page image or PDF points. Converted coordinates have
+// their origin at the top-left corner of the page.
+type PageDimensions struct {
+	width  float64 // Width in PDF points
+	height float64 // Height in PDF points
+	dpi    float64 // Resolution of the page image in dots per inch
+}
+
+// NewPageDimensionsFromImage creates page dimensions from the size of the page image in pixels and its
+// resolution in dots per inch. If the resolution is not positive, 72 dpi is assumed, so that pixels and
+// PDF points coincide. It returns an error if the width or height is not positive.
+func NewPageDimensionsFromImage(width, height int, dpi float64) (*PageDimensions, error) {
+	if dpi <= 0 {
+		dpi = pointsPerInch
+	}
+
+	return NewPageDimensionsFromPDF(float64(width)/dpi*pointsPerInch, float64(height)/dpi*pointsPerInch, dpi)
+}
+
+// NewPageDimensionsFromPDF creates page dimensions from the size of the PDF page in points and the
+// resolution in dots per inch at which the page is rendered. If the resolution is not positive, 72 dpi
+// is assumed, so that pixels and PDF points coincide. It returns an error if the width or height is not positive.
+func NewPageDimensionsFromPDF(width, height, dpi float64) (*PageDimensions, error) {
+	if width <= 0 || height <= 0 {
+		return nil, fmt.Errorf("invalid page size %gx%g: width and height must be positive", width, height)
+	}
+
+	if dpi <= 0 {
+		dpi = pointsPerInch
+	}
+
+	return &PageDimensions{
+		width:  width,
+		height: height,
+		dpi:    dpi,
+	}, nil
+}
+
+// DecodePageDimensions creates page dimensions from the size of a PNG or JPEG page image read from r.
+// Only the image header is decoded. See NewPageDimensionsFromImage for the resolution.
+func DecodePageDimensions(r io.Reader, dpi float64) (*PageDimensions, error) {
+	cfg, _, err := image.DecodeConfig(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode page image: %w", err)
+	}
+
+	return NewPageDimensionsFromImage(cfg.Width, cfg.Height, dpi)
+}
+
+// Width returns the width of the page in the given unit.
+func (pd *PageDimensions) Width(unit Unit) float64 {
+	width, _ := pd.size(unit)
+	return width
+}
+
+// Height returns the height of the page in the given unit.
+func (pd *PageDimensions) Height(unit Unit) float64 {
+	_, height := pd.size(unit)
+	return height
+}
+
+// DPI returns the resolution of the page image in dots per inch.
+func (pd *PageDimensions) DPI() float64 {
+	return pd.dpi
+}
+
+// size returns the width and height of the page in the given unit. Normalized pages, and pages
+// in unknown units, have a size of 1.
+func (pd *PageDimensions) size(unit Unit) (float64, float64) {
+	switch unit {
+	case UnitPixel:
+		return pd.width / pointsPerInch * pd.dpi, pd.height / pointsPerInch * pd.dpi
+	case UnitPoint:
+		return pd.width, pd.height
+	default:
+		return 1, 1
+	}
+}
+
+// Dimensions returns the real size of the page, or nil if it has not been set.
+func (p *Page) Dimensions() *PageDimensions {
+	return p.dimensions
+}
+
+// SetDimensions sets the real size of the page used to convert coordinates into pixels or PDF points.
+func (p *Page) SetDimensions(dimensions *PageDimensions) {
+	p.dimensions = dimensions
+}
+
+// SetDimensions sets the same real size for all pages of the document.
+func (d *Document) SetDimensions(dimensions *PageDimensions) {
+	for _, p := range d.pages {
+		p.SetDimensions(dimensions)
+	}
+}
+
+// Convert returns a copy of the point converted from one unit of the page dimensions into another,
+// e.g. from normalized coordinates into pixels. It returns nil if the dimensions are nil.
+func (p *Point) Convert(dimensions *PageDimensions, from, to Unit) *Point {
+	if dimensions == nil {
+		return nil
+	}
+
+	fromWidth, fromHeight := dimensions.size(from)
+	toWidth, toHeight := dimensions.size(to)
+
+	return &Point{
+		x: p.x / fromWidth * toWidth,
+		y: p.y / fromHeight * toHeight,
+	}
+}
+
+// Convert returns a copy of the polygon converted from one unit of the page dimensions into another.
+// It returns nil if the dimensions are nil.
+func (p Polygon) Convert(dimensions *PageDimensions, from, to Unit) Polygon {
+	if dimensions == nil || p == nil {
+		return nil
+	}
+
+	polygon := make(Polygon, len(p))
+	for i, point := range p {
+		polygon[i] = point.Convert(dimensions, from, to)
+	}
+
+	return polygon
+}
+
+// Convert returns a copy of the bounding box converted from one unit of the page dimensions into another,
+// e.g. a region drawn onto the page image from pixels into normalized coordinates. It returns nil if the
+// dimensions are nil.
+func (bb *BoundingBox) Convert(dimensions *PageDimensions, from, to Unit) *BoundingBox {
+	if dimensions == nil {
+		return nil
+	}
+
+	fromWidth, fromHeight := dimensions.size(from)
+	toWidth, toHeight := dimensions.size(to)
+
+	return &BoundingBox{
+		height: bb.height / fromHeight * toHeight,
+		left:   bb.left / fromWidth * toWidth,
+		top:    bb.top / fromHeight * toHeight,
+		width:  bb.width / fromWidth * toWidth,
+	}
+}
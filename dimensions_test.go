@@ -0,0 +1,123 @@
+package textractor
+
+import (
+	"bytes"
+	"image"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPageDimensions(t *testing.T) {
+	t.Run("FromImage", func(t *testing.T) {
+		pd, err := NewPageDimensionsFromImage(1700, 2200, 200)
+		assert.NoError(t, err)
+
+		assert.Equal(t, 1700.0, pd.Width(UnitPixel))
+		assert.Equal(t, 2200.0, pd.Height(UnitPixel))
+		assert.InDelta(t, 612.0, pd.Width(UnitPoint), 1e-9)
+		assert.InDelta(t, 792.0, pd.Height(UnitPoint), 1e-9)
+		assert.Equal(t, 1.0, pd.Width(UnitNormalized))
+		assert.Equal(t, 200.0, pd.DPI())
+	})
+
+	t.Run("FromPDF", func(t *testing.T) {
+		pd, err := NewPageDimensionsFromPDF(612, 792, 0)
+		assert.NoError(t, err)
+
+		assert.Equal(t, 612.0, pd.Width(UnitPixel), "Pixels and points should coincide at 72 dpi")
+		assert.Equal(t, 792.0, pd.Height(UnitPoint))
+		assert.Equal(t, 72.0, pd.DPI())
+	})
+
+	t.Run("Decode", func(t *testing.T) {
+		var buf bytes.Buffer
+		assert.NoError(t, png.Encode(&buf, image.NewGray(image.Rect(0, 0, 300, 400))))
+
+		pd, err := DecodePageDimensions(&buf, 150)
+		assert.NoError(t, err)
+		assert.Equal(t, 300.0, pd.Width(UnitPixel))
+		assert.Equal(t, 400.0, pd.Height(UnitPixel))
+		assert.InDelta(t, 144.0, pd.Width(UnitPoint), 1e-9)
+
+		_, err = DecodePageDimensions(bytes.NewReader([]byte("no image")), 150)
+		assert.Error(t, err)
+	})
+
+	t.Run("InvalidSize", func(t *testing.T) {
+		_, err := NewPageDimensionsFromImage(0, 2200, 200)
+		assert.Error(t, err)
+
+		_, err = NewPageDimensionsFromPDF(612, -792, 72)
+		assert.Error(t, err)
+	})
+}
+
+func TestConvert(t *testing.T) {
+	pd, err := NewPageDimensionsFromPDF(600, 800, 144)
+	assert.NoError(t, err)
+
+	t.Run("Point", func(t *testing.T) {
+		p := (&Point{x: 0.5, y: 0.25}).Convert(pd, UnitNormalized, UnitPixel)
+		assert.Equal(t, &Point{x: 600, y: 400}, p)
+		assert.Equal(t, &Point{x: 300, y: 200}, p.Convert(pd, UnitPixel, UnitPoint))
+		assert.Equal(t, &Point{x: 0.5, y: 0.25}, p.Convert(pd, UnitPixel, UnitNormalized))
+		assert.Nil(t, p.Convert(nil, UnitPixel, UnitNormalized))
+	})
+
+	t.Run("Polygon", func(t *testing.T) {
+		polygon := Polygon{{x: 0, y: 0}, {x: 1, y: 0}, {x: 1, y: 1}}
+		assert.Equal(t, Polygon{{x: 0, y: 0}, {x: 600, y: 0}, {x: 600, y: 800}}, polygon.Convert(pd, UnitNormalized, UnitPoint))
+		assert.Nil(t, Polygon(nil).Convert(pd, UnitNormalized, UnitPoint))
+	})
+
+	t.Run("BoundingBox", func(t *testing.T) {
+		// A region drawn onto the page image
+		region := NewBoundingBox(120, 160, 240, 320)
+
+		bb := region.Convert(pd, UnitPixel, UnitNormalized)
+		assert.InDelta(t, 0.1, bb.Left(), 1e-9)
+		assert.InDelta(t, 0.1, bb.Top(), 1e-9)
+		assert.InDelta(t, 0.2, bb.Width(), 1e-9)
+		assert.InDelta(t, 0.2, bb.Height(), 1e-9)
+		assert.Equal(t, region, bb.Convert(pd, UnitNormalized, UnitPixel))
+	})
+
+	t.Run("Block", func(t *testing.T) {
+		page := &Page{}
+		w := &Word{base: base{boundingBox: NewBoundingBox(0.1, 0.2, 0.3, 0.4), polygon: Polygon{{x: 0.1, y: 0.2}}, page: page}}
+
+		assert.Nil(t, w.BoundingBoxIn(UnitPixel), "Conversion should require page dimensions")
+		assert.Nil(t, w.PolygonIn(UnitPixel))
+
+		page.SetDimensions(pd)
+		assert.Equal(t, NewBoundingBox(60, 160, 180, 320), w.BoundingBoxIn(UnitPoint))
+		assert.Equal(t, Polygon{{x: 120, y: 320}}, w.PolygonIn(UnitPixel))
+	})
+
+	t.Run("KeyValue", func(t *testing.T) {
+		page := &Page{}
+		kv := &KeyValue{
+			key:   &Key{base: base{boundingBox: NewBoundingBox(0.1, 0.2, 0.2, 0.1), polygon: Polygon{{x: 0.1, y: 0.2}, {x: 0.3, y: 0.2}, {x: 0.3, y: 0.3}, {x: 0.1, y: 0.3}}, page: page}},
+			value: &Value{base: base{boundingBox: NewBoundingBox(0.3, 0.2, 0.2, 0.1), polygon: Polygon{{x: 0.3, y: 0.2}, {x: 0.5, y: 0.2}, {x: 0.5, y: 0.3}, {x: 0.3, y: 0.3}}, page: page}},
+			page:  page,
+		}
+
+		assert.Nil(t, kv.BoundingBoxIn(UnitPixel), "Conversion should require page dimensions")
+		assert.Nil(t, kv.PolygonIn(UnitPixel))
+
+		page.SetDimensions(pd)
+
+		bb := kv.BoundingBoxIn(UnitPoint)
+		assert.InDelta(t, 60, bb.Left(), 1e-9)
+		assert.InDelta(t, 160, bb.Top(), 1e-9)
+		assert.InDelta(t, 240, bb.Width(), 1e-9)
+		assert.InDelta(t, 80, bb.Height(), 1e-9)
+
+		polygon := kv.PolygonIn(UnitPoint)
+		assert.Len(t, polygon, 4)
+		assert.InDelta(t, 300, polygon[1].X(), 1e-9)
+		assert.InDelta(t, 240, polygon[2].Y(), 1e-9)
+	})
+}
@@ -1,6 +1,7 @@
 package textractor
 
 import (
+	"slices"
 	"strings"
 
 	"github.com/hupe1980/go-textractor/internal"
@@ -8,7 +9,8 @@ import (
 
 // Document represents a document consisting of multiple pages.
 type Document struct {
-	pages []*Page
+	pages  []*Page
+	report *ParseReport
 }
 
 // Pages returns the slice of Page objects in the document.
@@ -16,6 +18,15 @@ func (d *Document) Pages() []*Page {
 	return d.pages
 }
 
+// ParseReport returns the problems found while parsing the document.
+func (d *Document) ParseReport() *ParseReport {
+	if d.report == nil {
+		return &ParseReport{}
+	}
+
+	return d.report
+}
+
 // Words returns a slice containing all the words in the document.
 func (d *Document) Words() []*Word {
 	words := make([][]*Word, 0, len(d.Pages()))
@@ -71,6 +82,72 @@ func (d *Document) Signatures() []*Signature {
 	return internal.Concatenate(signatures...)
 }
 
+// Queries returns the queries of all pages. Queries with the same text and alias asked on several
+// pages are merged into a single query with the results of all pages.
+func (d *Document) Queries() []*Query {
+	var (
+		groups  [][]*Query
+		indexes = make(map[[2]string]int)
+	)
+
+	for _, p := range d.pages {
+		for _, q := range p.queries {
+			key := [2]string{q.text, q.alias}
+
+			if i, ok := indexes[key]; ok {
+				groups[i] = append(groups[i], q)
+				continue
+			}
+
+			indexes[key] = len(groups)
+			groups = append(groups, []*Query{q})
+		}
+	}
+
+	queries := make([]*Query, len(groups))
+
+	for i, g := range groups {
+		if len(g) == 1 {
+			queries[i] = g[0]
+			continue
+		}
+
+		q := &Query{
+			id:    g[0].id,
+			text:  g[0].text,
+			alias: g[0].alias,
+			page:  g[0].page,
+			raw:   g[0].raw,
+		}
+
+		for _, pq := range g {
+			for _, qp := range pq.queryPages {
+				if !slices.Contains(q.queryPages, qp) {
+					q.queryPages = append(q.queryPages, qp)
+				}
+			}
+
+			q.results = append(q.results, pq.results...)
+		}
+
+		queries[i] = q
+	}
+
+	return queries
+}
+
+// QueryByAlias returns the query of the document with the given alias, with the results of all pages,
+// or nil if there is none.
+func (d *Document) QueryByAlias(alias string) *Query {
+	for _, q := range d.Queries() {
+		if q.alias == alias {
+			return q
+		}
+	}
+
+	return nil
+}
+
 // Text linearizes the document into a single text string, optionally applying specified options.
 func (d *Document) Text(optFns ...func(*TextLinearizationOptions)) string {
 	pageTexts := make([]string, len(d.Pages()))
@@ -0,0 +1,252 @@
+package textractor
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/textract"
+	"github.com/aws/aws-sdk-go-v2/service/textract/types"
+)
+
+// ErrJobFailed is returned when an asynchronous Textract job finishes with status FAILED.
+var ErrJobFailed = errors.New("textract job failed")
+
+// DocumentAnalysisClient is the subset of the Textract client used by the DocumentAnalysisJobRunner.
+type DocumentAnalysisClient interface {
+	StartDocumentAnalysis(ctx context.Context, params *textract.StartDocumentAnalysisInput, optFns ...func(*textract.Options)) (*textract.StartDocumentAnalysisOutput, error)
+	GetDocumentAnalysis(ctx context.Context, params *textract.GetDocumentAnalysisInput, optFns ...func(*textract.Options)) (*textract.GetDocumentAnalysisOutput, error)
+}
+
+// Compile time check to ensure the Textract client satisfies the DocumentAnalysisClient interface.
+var _ DocumentAnalysisClient = (*textract.Client)(nil)
+
+// WaitForCompletion is a callback that blocks until Textract has published the completion
+// status of the job with the given ID, e.g. by consuming an SNS/SQS notification.
+type WaitForCompletion func(ctx context.Context, jobID string) (types.JobStatus, error)
+
+// DocumentAnalysisJobRunnerOptions defines how a DocumentAnalysisJobRunner waits for and collects job results.
+type DocumentAnalysisJobRunnerOptions struct {
+	// PollInterval is the initial delay between two job status polls. Non-positive values are replaced by the default of one second.
+	PollInterval time.Duration
+
+	// MaxPollInterval is the upper bound for the delay between two job status polls.
+	MaxPollInterval time.Duration
+
+	// BackoffMultiplier is the factor by which the poll interval grows after each poll. Values below one are raised to one,
+	// so that the poll interval never shrinks.
+	BackoffMultiplier float64
+
+	// MaxResults is the maximum number of blocks requested per GetDocumentAnalysis call. Zero uses the service default.
+	MaxResults int32
+
+	// WaitForCompletion, if set, replaces polling with a completion notification.
+	WaitForCompletion WaitForCompletion
+}
+
+// DocumentAnalysisJobRunner starts asynchronous document analysis jobs, waits for their
+// completion and parses the paginated results into a Document.
+type DocumentAnalysisJobRunner struct {
+	client DocumentAnalysisClient
+	opts   DocumentAnalysisJobRunnerOptions
+}
+
+// NewDocumentAnalysisJobRunner creates a new DocumentAnalysisJobRunner instance.
+func NewDocumentAnalysisJobRunner(client DocumentAnalysisClient, optFns ...func(o *DocumentAnalysisJobRunnerOptions)) *DocumentAnalysisJobRunner {
+	opts := DocumentAnalysisJobRunnerOptions{
+		PollInterval:      time.Second,
+		MaxPollInterval:   30 * time.Second,
+		BackoffMultiplier: 2,
+	}
+
+	for _, fn := range optFns {
+		fn(&opts)
+	}
+
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = time.Second
+	}
+
+	if opts.BackoffMultiplier < 1 {
+		opts.BackoffMultiplier = 1
+	}
+
+	return &DocumentAnalysisJobRunner{
+		client: client,
+		opts:   opts,
+	}
+}
+
+// Run starts a document analysis job, waits for its completion and returns the parsed Document.
+// The parse options are passed on to ParseDocumentAPIOutput.
+func (r *DocumentAnalysisJobRunner) Run(ctx context.Context, input *textract.StartDocumentAnalysisInput, optFns ...func(*ParseOptions)) (*Document, error) {
+	jobID, err := r.Start(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Wait(ctx, jobID, optFns...)
+}
+
+// Start starts a document analysis job and returns its job ID.
+func (r *DocumentAnalysisJobRunner) Start(ctx context.Context, input *textract.StartDocumentAnalysisInput) (string, error) {
+	output, err := r.client.StartDocumentAnalysis(ctx, input)
+	if err != nil {
+		return "", err
+	}
+
+	return aws.ToString(output.JobId), nil
+}
+
+// Wait waits for the job with the given ID to complete and returns the parsed Document.
+// The parse options are passed on to ParseDocumentAPIOutput.
+func (r *DocumentAnalysisJobRunner) Wait(ctx context.Context, jobID string, optFns ...func(*ParseOptions)) (*Document, error) {
+	first, err := r.waitForFirstPage(ctx, jobID)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &DocumentAPIOutput{
+		DocumentMetadata: first.DocumentMetadata,
+		Blocks:           first.Blocks,
+	}
+
+	for nextToken := first.NextToken; nextToken != nil; {
+		page, err := r.getDocumentAnalysis(ctx, jobID, nextToken)
+		if err != nil {
+			return nil, err
+		}
+
+		output.Blocks = append(output.Blocks, page.Blocks...)
+		nextToken = page.NextToken
+	}
+
+	return ParseDocumentAPIOutput(output, optFns...)
+}
+
+// waitForFirstPage waits for the job to complete and returns the first page of its results.
+func (r *DocumentAnalysisJobRunner) waitForFirstPage(ctx context.Context, jobID string) (*textract.GetDocumentAnalysisOutput, error) {
+	if r.opts.WaitForCompletion == nil {
+		return r.poll(ctx, jobID)
+	}
+
+	status, err := r.opts.WaitForCompletion(ctx, jobID)
+	if err != nil {
+		return nil, err
+	}
+
+	if status == types.JobStatusFailed {
+		return nil, fmt.Errorf("%w: job %s", ErrJobFailed, jobID)
+	}
+
+	output, err := r.getDocumentAnalysis(ctx, jobID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkJobStatus(jobID, output); err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// poll polls the job status with exponential backoff until the job is no longer in progress.
+func (r *DocumentAnalysisJobRunner) poll(ctx context.Context, jobID string) (*textract.GetDocumentAnalysisOutput, error) {
+	interval := r.opts.PollInterval
+
+	for {
+		output, err := r.getDocumentAnalysis(ctx, jobID, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		if output.JobStatus != types.JobStatusInProgress {
+			if err := checkJobStatus(jobID, output); err != nil {
+				return nil, err
+			}
+
+			return output, nil
+		}
+
+		timer := time.NewTimer(interval)
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+
+		interval = time.Duration(float64(interval) * r.opts.BackoffMultiplier)
+		if r.opts.MaxPollInterval > 0 && interval > r.opts.MaxPollInterval {
+			interval = r.opts.MaxPollInterval
+		}
+	}
+}
+
+// getDocumentAnalysis fetches a single result page of the job with the given ID.
+func (r *DocumentAnalysisJobRunner) getDocumentAnalysis(ctx context.Context, jobID string, nextToken *string) (*textract.GetDocumentAnalysisOutput, error) {
+	input := &textract.GetDocumentAnalysisInput{
+		JobId:     aws.String(jobID),
+		NextToken: nextToken,
+	}
+
+	if r.opts.MaxResults > 0 {
+		input.MaxResults = aws.Int32(r.opts.MaxResults)
+	}
+
+	return r.client.GetDocumentAnalysis(ctx, input)
+}
+
+// checkJobStatus returns an error if the job did not succeed.
+func checkJobStatus(jobID string, output *textract.GetDocumentAnalysisOutput) error {
+	switch output.JobStatus { // nolint exhaustive
+	case types.JobStatusSucceeded, types.JobStatusPartialSuccess:
+		return nil
+	case types.JobStatusFailed:
+		return fmt.Errorf("%w: job %s: %s", ErrJobFailed, jobID, aws.ToString(output.StatusMessage))
+	default:
+		return fmt.Errorf("unexpected status %s for job %s", output.JobStatus, jobID)
+	}
+}
+
+// JobCompletionNotification represents the completion status Textract publishes to the SNS topic of an asynchronous job.
+type JobCompletionNotification struct {
+	JobID     string          `json:"JobId"`
+	Status    types.JobStatus `json:"Status"`
+	API       string          `json:"API"`
+	JobTag    string          `json:"JobTag"`
+	Timestamp int64           `json:"Timestamp"`
+}
+
+// ParseJobCompletionNotification parses a Textract completion notification. It accepts the raw SNS
+// message as well as the SNS envelope that is delivered to subscribed SQS queues.
+func ParseJobCompletionNotification(message []byte) (*JobCompletionNotification, error) {
+	var envelope struct {
+		Type    string `json:"Type"`
+		Message string `json:"Message"`
+	}
+
+	if err := json.Unmarshal(message, &envelope); err != nil {
+		return nil, err
+	}
+
+	if envelope.Type == "Notification" && envelope.Message != "" {
+		message = []byte(envelope.Message)
+	}
+
+	notification := new(JobCompletionNotification)
+	if err := json.Unmarshal(message, notification); err != nil {
+		return nil, err
+	}
+
+	if notification.JobID == "" {
+		return nil, fmt.Errorf("notification does not contain a job id")
+	}
+
+	return notification, nil
+}
@@ -0,0 +1,204 @@
+package textractor
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/textract"
+	"github.com/aws/aws-sdk-go-v2/service/textract/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDocumentAnalysisJobRunner(t *testing.T) {
+	res, err := loadDocumentAPIOutputTestdata("testdata/test-document.json")
+	assert.NoError(t, err)
+
+	t.Run("Polling", func(t *testing.T) {
+		client := &documentAnalysisClientMock{
+			output:      res,
+			pageSize:    100,
+			inProgress:  2,
+			finalStatus: types.JobStatusSucceeded,
+		}
+
+		runner := NewDocumentAnalysisJobRunner(client, func(o *DocumentAnalysisJobRunnerOptions) {
+			o.PollInterval = time.Millisecond
+		})
+
+		doc, err := runner.Run(context.Background(), &textract.StartDocumentAnalysisInput{})
+		assert.NoError(t, err)
+
+		assert.Equal(t, 51, len(doc.Words()))
+		assert.Equal(t, 5, len(doc.KeyValues()))
+		assert.Equal(t, 1, len(doc.Tables()))
+		assert.Equal(t, 3, client.polls)
+	})
+
+	t.Run("WaitForCompletion", func(t *testing.T) {
+		client := &documentAnalysisClientMock{
+			output:      res,
+			pageSize:    100,
+			finalStatus: types.JobStatusSucceeded,
+		}
+
+		var notifiedJobID string
+
+		runner := NewDocumentAnalysisJobRunner(client, func(o *DocumentAnalysisJobRunnerOptions) {
+			o.WaitForCompletion = func(ctx context.Context, jobID string) (types.JobStatus, error) {
+				notifiedJobID = jobID
+				return types.JobStatusSucceeded, nil
+			}
+		})
+
+		doc, err := runner.Run(context.Background(), &textract.StartDocumentAnalysisInput{})
+		assert.NoError(t, err)
+		assert.Equal(t, "job-1", notifiedJobID)
+		assert.Equal(t, 51, len(doc.Words()))
+		assert.Equal(t, 1, client.polls)
+	})
+
+	t.Run("Failed", func(t *testing.T) {
+		client := &documentAnalysisClientMock{
+			output:      res,
+			finalStatus: types.JobStatusFailed,
+		}
+
+		runner := NewDocumentAnalysisJobRunner(client)
+
+		_, err := runner.Run(context.Background(), &textract.StartDocumentAnalysisInput{})
+		assert.ErrorIs(t, err, ErrJobFailed)
+	})
+
+	t.Run("ContextCanceled", func(t *testing.T) {
+		client := &documentAnalysisClientMock{
+			output:      res,
+			inProgress:  100,
+			finalStatus: types.JobStatusSucceeded,
+		}
+
+		runner := NewDocumentAnalysisJobRunner(client)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		_, err := runner.Run(ctx, &textract.StartDocumentAnalysisInput{})
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("ParseOptions", func(t *testing.T) {
+		client := &documentAnalysisClientMock{
+			output:      &DocumentAPIOutput{DocumentMetadata: &types.DocumentMetadata{Pages: aws.Int32(2)}, Blocks: res.Blocks},
+			pageSize:    100,
+			finalStatus: types.JobStatusSucceeded,
+		}
+
+		runner := NewDocumentAnalysisJobRunner(client)
+
+		doc, err := runner.Wait(context.Background(), "job-1")
+		assert.NoError(t, err)
+		assert.True(t, doc.ParseReport().HasWarnings())
+
+		_, err = runner.Wait(context.Background(), "job-1", func(o *ParseOptions) {
+			o.Strict = true
+		})
+
+		var mismatchErr *PageCountMismatchError
+		assert.ErrorAs(t, err, &mismatchErr)
+	})
+
+	t.Run("InvalidOptions", func(t *testing.T) {
+		runner := NewDocumentAnalysisJobRunner(&documentAnalysisClientMock{}, func(o *DocumentAnalysisJobRunnerOptions) {
+			o.PollInterval = 0
+			o.BackoffMultiplier = 0.5
+		})
+
+		assert.Equal(t, time.Second, runner.opts.PollInterval)
+		assert.Equal(t, 1.0, runner.opts.BackoffMultiplier)
+	})
+}
+
+func TestParseJobCompletionNotification(t *testing.T) {
+	t.Run("SNSMessage", func(t *testing.T) {
+		n, err := ParseJobCompletionNotification([]byte(`{"JobId":"job-1","Status":"SUCCEEDED","API":"StartDocumentAnalysis","JobTag":"tag","Timestamp":1}`))
+		assert.NoError(t, err)
+		assert.Equal(t, "job-1", n.JobID)
+		assert.Equal(t, types.JobStatusSucceeded, n.Status)
+		assert.Equal(t, "StartDocumentAnalysis", n.API)
+	})
+
+	t.Run("SQSEnvelope", func(t *testing.T) {
+		n, err := ParseJobCompletionNotification([]byte(`{"Type":"Notification","Message":"{\"JobId\":\"job-2\",\"Status\":\"FAILED\"}"}`))
+		assert.NoError(t, err)
+		assert.Equal(t, "job-2", n.JobID)
+		assert.Equal(t, types.JobStatusFailed, n.Status)
+	})
+
+	t.Run("MissingJobID", func(t *testing.T) {
+		_, err := ParseJobCompletionNotification([]byte(`{"Status":"FAILED"}`))
+		assert.Error(t, err)
+	})
+}
+
+type documentAnalysisClientMock struct {
+	output      *DocumentAPIOutput
+	pageSize    int
+	inProgress  int
+	finalStatus types.JobStatus
+	polls       int
+}
+
+func (m *documentAnalysisClientMock) StartDocumentAnalysis(_ context.Context, _ *textract.StartDocumentAnalysisInput, _ ...func(*textract.Options)) (*textract.StartDocumentAnalysisOutput, error) {
+	return &textract.StartDocumentAnalysisOutput{
+		JobId: aws.String("job-1"),
+	}, nil
+}
+
+func (m *documentAnalysisClientMock) GetDocumentAnalysis(_ context.Context, params *textract.GetDocumentAnalysisInput, _ ...func(*textract.Options)) (*textract.GetDocumentAnalysisOutput, error) {
+	if aws.ToString(params.JobId) != "job-1" {
+		return nil, errors.New("unknown job id")
+	}
+
+	if params.NextToken == nil {
+		m.polls++
+
+		if m.polls <= m.inProgress {
+			return &textract.GetDocumentAnalysisOutput{JobStatus: types.JobStatusInProgress}, nil
+		}
+	}
+
+	if m.finalStatus == types.JobStatusFailed {
+		return &textract.GetDocumentAnalysisOutput{
+			JobStatus:     types.JobStatusFailed,
+			StatusMessage: aws.String("invalid document"),
+		}, nil
+	}
+
+	start := 0
+
+	if params.NextToken != nil {
+		s, err := strconv.Atoi(aws.ToString(params.NextToken))
+		if err != nil {
+			return nil, err
+		}
+
+		start = s
+	}
+
+	end := min(start+m.pageSize, len(m.output.Blocks))
+
+	output := &textract.GetDocumentAnalysisOutput{
+		JobStatus:        m.finalStatus,
+		DocumentMetadata: m.output.DocumentMetadata,
+		Blocks:           m.output.Blocks[start:end],
+	}
+
+	if end < len(m.output.Blocks) {
+		output.NextToken = aws.String(strconv.Itoa(end))
+	}
+
+	return output, nil
+}
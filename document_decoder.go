@@ -0,0 +1,294 @@
+package textractor
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/textract/types"
+)
+
+// DocumentDecoder reads a Textract Document API response from a JSON stream and parses it page by page,
+// so that only the blocks of a single page are held in memory. The stream may contain a single response
+// object, several response objects, e.g. the result pages of an asynchronous job, or an array of them.
+//
+// Blocks are expected in page order, as returned by Textract: each page starts with its PAGE block,
+// followed by the blocks of the page. Blocks referencing blocks of other pages are reported as dangling.
+type DocumentDecoder struct {
+	dec      *json.Decoder
+	opts     ParseOptions
+	report   *ParseReport
+	metadata *types.DocumentMetadata
+
+	// Position in the JSON stream
+	inArray  bool
+	inObject bool
+	inBlocks bool
+
+	blocks     []types.Block // Blocks of the current page
+	pageNumber int32         // Number of the current page
+	hasPage    bool          // Whether the PAGE block of the current page has been read
+	pageCount  int           // Number of parsed pages
+	ready      []*Page       // Parsed pages not yet returned
+	done       bool          // Whether the end of the stream has been reached
+	err        error         // Error returned once all pages have been returned
+}
+
+// NewDocumentDecoder creates a new DocumentDecoder reading from r. Pages are parsed one at a time,
+// so the Concurrency option is ignored.
+func NewDocumentDecoder(r io.Reader, optFns ...func(*ParseOptions)) *DocumentDecoder {
+	opts := ParseOptions{}
+
+	for _, fn := range optFns {
+		fn(&opts)
+	}
+
+	return &DocumentDecoder{
+		dec:    json.NewDecoder(r),
+		opts:   opts,
+		report: &ParseReport{},
+	}
+}
+
+// Next returns the next parsed page. It returns io.EOF once all pages have been returned. If strict
+// parsing is enabled, problems found in a page are returned as error, and a PageCountMismatchError
+// is returned instead of io.EOF if the number of pages does not match the document metadata.
+func (d *DocumentDecoder) Next() (*Page, error) {
+	for {
+		if len(d.ready) > 0 {
+			page := d.ready[0]
+			d.ready = d.ready[1:]
+
+			return page, nil
+		}
+
+		if d.done {
+			return nil, d.err
+		}
+
+		b, err := d.nextBlock()
+		if errors.Is(err, io.EOF) {
+			d.done, d.err = true, io.EOF
+
+			if err := d.flush(); err != nil {
+				return nil, err
+			}
+
+			if err := checkPageCount(d.pageCount, d.metadata); err != nil {
+				d.report.add(err)
+
+				if d.opts.Strict {
+					d.err = err
+				}
+			}
+
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if b.BlockType == types.BlockTypePage && d.hasPage {
+			if err := d.flush(); err != nil {
+				return nil, err
+			}
+		}
+
+		if err := d.add(b); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// ParseReport returns the problems found in the pages parsed so far.
+func (d *DocumentDecoder) ParseReport() *ParseReport {
+	return d.report
+}
+
+// DocumentMetadata returns the metadata of the response, or nil if it has not been read yet.
+func (d *DocumentDecoder) DocumentMetadata() *types.DocumentMetadata {
+	return d.metadata
+}
+
+// add adds the block to the current page. Blocks of other pages are reported and skipped, or
+// returned as error if strict parsing is enabled, in which case the decoder returns the error from then on.
+func (d *DocumentDecoder) add(b types.Block) error {
+	if b.BlockType == types.BlockTypePage {
+		d.hasPage = true
+		d.pageNumber = aws.ToInt32(b.Page)
+	} else if d.hasPage && b.Page != nil && aws.ToInt32(b.Page) != d.pageNumber {
+		err := &InvalidBlockError{
+			BlockID:   aws.ToString(b.Id),
+			BlockType: b.BlockType,
+			Reason:    fmt.Sprintf("block of page %d follows the blocks of page %d", aws.ToInt32(b.Page), d.pageNumber),
+		}
+
+		d.report.add(err)
+
+		if d.opts.Strict {
+			d.done, d.err = true, err
+			return err
+		}
+
+		return nil
+	}
+
+	d.blocks = append(d.blocks, b)
+
+	return nil
+}
+
+// flush parses the blocks of the current page. If strict parsing is enabled and problems are found,
+// the page is dropped and the decoder returns the error from then on.
+func (d *DocumentDecoder) flush() error {
+	if len(d.blocks) == 0 {
+		return nil
+	}
+
+	parser := newBlockParser(d.blocks)
+	parser.polygonOverlap = d.opts.PolygonOverlap
+
+	if d.opts.CorrectOrientation {
+		parser.correctOrientation()
+	}
+
+	doc := parser.createDocument()
+
+	d.blocks, d.hasPage = nil, false
+	d.report.warnings = append(d.report.warnings, parser.report.warnings...)
+
+	if d.opts.Strict && parser.report.HasWarnings() {
+		d.done, d.err = true, parser.report.Err()
+		return d.err
+	}
+
+	d.pageCount += len(doc.pages)
+	d.ready = append(d.ready, doc.pages...)
+
+	return nil
+}
+
+// nextBlock reads the next block from the stream. It returns io.EOF at the end of the stream.
+func (d *DocumentDecoder) nextBlock() (types.Block, error) {
+	for {
+		switch {
+		case d.inBlocks:
+			if d.dec.More() {
+				var b types.Block
+				if err := d.dec.Decode(&b); err != nil {
+					return types.Block{}, fmt.Errorf("failed to decode block: %w", err)
+				}
+
+				return b, nil
+			}
+
+			if err := d.expectDelim(']'); err != nil {
+				return types.Block{}, err
+			}
+
+			d.inBlocks = false
+		case d.inObject:
+			if !d.dec.More() {
+				if err := d.expectDelim('}'); err != nil {
+					return types.Block{}, err
+				}
+
+				d.inObject = false
+
+				continue
+			}
+
+			if err := d.readField(); err != nil {
+				return types.Block{}, err
+			}
+		case d.inArray && !d.dec.More():
+			if err := d.expectDelim(']'); err != nil {
+				return types.Block{}, err
+			}
+
+			d.inArray = false
+		default:
+			tok, err := d.dec.Token()
+			if err != nil {
+				if errors.Is(err, io.EOF) {
+					return types.Block{}, io.EOF
+				}
+
+				return types.Block{}, fmt.Errorf("failed to decode response: %w", err)
+			}
+
+			switch tok {
+			case json.Delim('{'):
+				d.inObject = true
+			case json.Delim('['):
+				if d.inArray {
+					return types.Block{}, fmt.Errorf("failed to decode response: unexpected nested array")
+				}
+
+				d.inArray = true
+			default:
+				return types.Block{}, fmt.Errorf("failed to decode response: unexpected token %v", tok)
+			}
+		}
+	}
+}
+
+// readField reads a field of a response object. The blocks are streamed, other fields are skipped.
+func (d *DocumentDecoder) readField() error {
+	tok, err := d.dec.Token()
+	if err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	key, _ := tok.(string)
+
+	switch {
+	case strings.EqualFold(key, "Blocks"):
+		tok, err := d.dec.Token()
+		if err != nil {
+			return fmt.Errorf("failed to decode blocks: %w", err)
+		}
+
+		switch tok {
+		case json.Delim('['):
+			d.inBlocks = true
+		case nil:
+		default:
+			return fmt.Errorf("failed to decode blocks: unexpected token %v", tok)
+		}
+	case strings.EqualFold(key, "DocumentMetadata"):
+		var metadata *types.DocumentMetadata
+		if err := d.dec.Decode(&metadata); err != nil {
+			return fmt.Errorf("failed to decode document metadata: %w", err)
+		}
+
+		if metadata != nil {
+			d.metadata = metadata
+		}
+	default:
+		var raw json.RawMessage
+		if err := d.dec.Decode(&raw); err != nil {
+			return fmt.Errorf("failed to decode %s: %w", key, err)
+		}
+	}
+
+	return nil
+}
+
+// expectDelim reads the given delimiter from the stream.
+func (d *DocumentDecoder) expectDelim(delim json.Delim) error {
+	tok, err := d.dec.Token()
+	if err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	if tok != delim {
+		return fmt.Errorf("failed to decode response: expected %v, got %v", delim, tok)
+	}
+
+	return nil
+}
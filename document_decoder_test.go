@@ -0,0 +1,262 @@
+package textractor
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/textract/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDocumentDecoder(t *testing.T) {
+	testCases := []string{
+		"testdata/test-document.json",
+		"testdata/test-layout.json",
+		"testdata/test-simple-table-layout.json",
+		"testdata/test-response-for-llm.json",
+		"testdata/test-response.json",
+	}
+
+	for _, filename := range testCases {
+		t.Run(filename, func(t *testing.T) {
+			res, err := loadDocumentAPIOutputTestdata(filename)
+			assert.NoError(t, err)
+
+			doc, err := ParseDocumentAPIOutput(res)
+			assert.NoError(t, err)
+
+			f, err := os.Open(filename)
+			assert.NoError(t, err)
+
+			defer f.Close()
+
+			pages := decodeAllPages(t, NewDocumentDecoder(f))
+			assert.Len(t, pages, len(doc.Pages()))
+
+			for i, p := range pages {
+				assert.Equal(t, doc.Pages()[i].Text(), p.Text())
+				assert.Len(t, p.Words(), len(doc.Pages()[i].Words()))
+			}
+		})
+	}
+
+	t.Run("MultiPage", func(t *testing.T) {
+		output := newMultiPageDocumentAPIOutput(t, "testdata/test-response-for-llm.json", 5)
+
+		doc, err := ParseDocumentAPIOutput(output)
+		assert.NoError(t, err)
+
+		// The result pages of an asynchronous job
+		var results []*DocumentAPIOutput
+
+		for i := 0; i < len(output.Blocks); i += 1000 {
+			results = append(results, &DocumentAPIOutput{
+				DocumentMetadata: output.DocumentMetadata,
+				Blocks:           output.Blocks[i:min(i+1000, len(output.Blocks))],
+			})
+		}
+
+		encodings := map[string]func(*json.Encoder) error{
+			"Object": func(enc *json.Encoder) error {
+				return enc.Encode(output)
+			},
+			"Objects": func(enc *json.Encoder) error {
+				for _, r := range results {
+					if err := enc.Encode(r); err != nil {
+						return err
+					}
+				}
+
+				return nil
+			},
+			"Array": func(enc *json.Encoder) error {
+				return enc.Encode(results)
+			},
+		}
+
+		for name, encode := range encodings {
+			t.Run(name, func(t *testing.T) {
+				var buf bytes.Buffer
+				assert.NoError(t, encode(json.NewEncoder(&buf)))
+
+				dec := NewDocumentDecoder(&buf)
+
+				pages := decodeAllPages(t, dec)
+				assert.Len(t, pages, 5)
+
+				for i, p := range pages {
+					assert.Equal(t, i+1, p.Number())
+					assert.Equal(t, doc.Pages()[i].Text(), p.Text())
+				}
+
+				assert.False(t, dec.ParseReport().HasWarnings())
+				assert.Equal(t, int32(5), aws.ToInt32(dec.DocumentMetadata().Pages))
+			})
+		}
+	})
+
+	t.Run("BlockOutOfOrder", func(t *testing.T) {
+		output := newMultiPageDocumentAPIOutput(t, "testdata/test-response.json", 2)
+
+		// Move a line of the first page behind the second page
+		for i, b := range output.Blocks {
+			if b.BlockType == types.BlockTypeLine {
+				output.Blocks = append(append(output.Blocks[:i:i], output.Blocks[i+1:]...), b)
+				break
+			}
+		}
+
+		data, err := json.Marshal(output)
+		assert.NoError(t, err)
+
+		dec := NewDocumentDecoder(bytes.NewReader(data))
+		assert.Len(t, decodeAllPages(t, dec), 2)
+
+		var invalidBlockErr *InvalidBlockError
+		assert.True(t, errors.As(dec.ParseReport().Err(), &invalidBlockErr))
+
+		strict := NewDocumentDecoder(bytes.NewReader(data), func(o *ParseOptions) {
+			o.Strict = true
+		})
+
+		for err == nil {
+			_, err = strict.Next()
+		}
+
+		assert.True(t, errors.As(err, &invalidBlockErr))
+
+		// The decoder stops at the error instead of returning later pages
+		for i := 0; i < 2; i++ {
+			page, err := strict.Next()
+			assert.Nil(t, page)
+			assert.True(t, errors.As(err, &invalidBlockErr))
+		}
+	})
+
+	t.Run("StrictInvalidPage", func(t *testing.T) {
+		output := newMultiPageDocumentAPIOutput(t, "testdata/test-response.json", 2)
+		output.Blocks[1].Relationships = append(output.Blocks[1].Relationships, types.Relationship{
+			Type: types.RelationshipTypeChild,
+			Ids:  []string{"dangling"},
+		})
+
+		data, err := json.Marshal(output)
+		assert.NoError(t, err)
+
+		dec := NewDocumentDecoder(bytes.NewReader(data), func(o *ParseOptions) {
+			o.Strict = true
+		})
+
+		var danglingErr *DanglingBlockIDError
+
+		for i := 0; i < 2; i++ {
+			page, err := dec.Next()
+			assert.Nil(t, page)
+			assert.True(t, errors.As(err, &danglingErr))
+		}
+	})
+
+	t.Run("PageCountMismatch", func(t *testing.T) {
+		output := newMultiPageDocumentAPIOutput(t, "testdata/test-response.json", 2)
+		output.DocumentMetadata.Pages = aws.Int32(3)
+
+		data, err := json.Marshal(output)
+		assert.NoError(t, err)
+
+		dec := NewDocumentDecoder(bytes.NewReader(data))
+		assert.Len(t, decodeAllPages(t, dec), 2)
+
+		var mismatchErr *PageCountMismatchError
+		assert.True(t, errors.As(dec.ParseReport().Err(), &mismatchErr))
+
+		strict := NewDocumentDecoder(bytes.NewReader(data), func(o *ParseOptions) {
+			o.Strict = true
+		})
+
+		for i := 0; i < 2; i++ {
+			_, err := strict.Next()
+			assert.NoError(t, err)
+		}
+
+		_, err = strict.Next()
+		assert.True(t, errors.As(err, &mismatchErr))
+	})
+
+	t.Run("InvalidJSON", func(t *testing.T) {
+		_, err := NewDocumentDecoder(strings.NewReader(`{"Blocks": [{"Id": 1}]}`)).Next()
+		assert.Error(t, err)
+
+		_, err = NewDocumentDecoder(strings.NewReader(`"Blocks"`)).Next()
+		assert.Error(t, err)
+
+		_, err = NewDocumentDecoder(strings.NewReader(``)).Next()
+		assert.ErrorIs(t, err, io.EOF)
+	})
+}
+
+// decodeAllPages reads all pages from the decoder.
+func decodeAllPages(t *testing.T, dec *DocumentDecoder) []*Page {
+	t.Helper()
+
+	var pages []*Page
+
+	for {
+		p, err := dec.Next()
+		if errors.Is(err, io.EOF) {
+			return pages
+		}
+
+		if !assert.NoError(t, err) {
+			return pages
+		}
+
+		pages = append(pages, p)
+	}
+}
+
+// newMultiPageDocumentAPIOutput repeats the single page of the test response, with unique block IDs
+// and page numbers, as the response of a document with the given number of pages.
+func newMultiPageDocumentAPIOutput(tb testing.TB, filename string, pages int) *DocumentAPIOutput {
+	tb.Helper()
+
+	res, err := loadDocumentAPIOutputTestdata(filename)
+	if err != nil {
+		tb.Fatal(err)
+	}
+
+	output := &DocumentAPIOutput{
+		DocumentMetadata: &types.DocumentMetadata{Pages: aws.Int32(int32(pages))},
+		Blocks:           make([]types.Block, 0, pages*len(res.Blocks)),
+	}
+
+	for page := 1; page <= pages; page++ {
+		prefix := fmt.Sprintf("p%d-", page)
+
+		for _, b := range res.Blocks {
+			b.Id = aws.String(prefix + aws.ToString(b.Id))
+			b.Page = aws.Int32(int32(page))
+
+			relationships := make([]types.Relationship, len(b.Relationships))
+
+			for i, r := range b.Relationships {
+				relationships[i] = types.Relationship{Type: r.Type, Ids: make([]string, len(r.Ids))}
+
+				for j, id := range r.Ids {
+					relationships[i].Ids[j] = prefix + id
+				}
+			}
+
+			b.Relationships = relationships
+			output.Blocks = append(output.Blocks, b)
+		}
+	}
+
+	return output
+}
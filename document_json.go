@@ -0,0 +1,887 @@
+package textractor
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/textract/types"
+)
+
+// DocumentJSONSchemaVersion is the version of the JSON schema written by Document.MarshalJSON.
+const DocumentJSONSchemaVersion = 1
+
+// Layout child kinds used in the JSON schema.
+const (
+	jsonLayoutChildLine      = "LINE"
+	jsonLayoutChildLayout    = "LAYOUT"
+	jsonLayoutChildTable     = "TABLE"
+	jsonLayoutChildKeyValue  = "KEY_VALUE"
+	jsonLayoutChildSignature = "SIGNATURE"
+)
+
+type jsonDocument struct {
+	Version int         `json:"version"`
+	Pages   []*jsonPage `json:"pages"`
+}
+
+type jsonPage struct {
+	Version    int              `json:"version,omitempty"`
+	ID         string           `json:"id"`
+	Number     int              `json:"number"`
+	Width      float64          `json:"width"`
+	Height     float64          `json:"height"`
+	ChildIDs   []string         `json:"childIds"`
+	Words      []*jsonWord      `json:"words"`
+	Lines      []*jsonLine      `json:"lines"`
+	KeyValues  []*jsonKeyValue  `json:"keyValues"`
+	Tables     []*jsonTable     `json:"tables"`
+	Layouts    []*jsonLayout    `json:"layouts"`
+	Queries    []*jsonQuery     `json:"queries"`
+	Signatures []*jsonSignature `json:"signatures"`
+	Dimensions *jsonDimensions  `json:"dimensions,omitempty"`
+}
+
+type jsonDimensions struct {
+	Width  float64 `json:"width"`
+	Height float64 `json:"height"`
+	DPI    float64 `json:"dpi"`
+}
+
+type jsonBoundingBox struct {
+	Height float64 `json:"height"`
+	Left   float64 `json:"left"`
+	Top    float64 `json:"top"`
+	Width  float64 `json:"width"`
+}
+
+type jsonPoint struct {
+	X float64 `json:"x"`
+	Y float64 `json:"y"`
+}
+
+type jsonBase struct {
+	ID          string           `json:"id"`
+	Confidence  float64          `json:"confidence"`
+	BlockType   types.BlockType  `json:"blockType"`
+	BoundingBox *jsonBoundingBox `json:"boundingBox"`
+	Polygon     []jsonPoint      `json:"polygon"`
+	Raw         *types.Block     `json:"raw,omitempty"`
+}
+
+type jsonWord struct {
+	jsonBase
+	Text     string         `json:"text"`
+	TextType types.TextType `json:"textType"`
+}
+
+type jsonLine struct {
+	jsonBase
+	WordIDs []string `json:"wordIds"`
+}
+
+type jsonSelectionElement struct {
+	jsonBase
+	Status types.SelectionStatus `json:"status"`
+}
+
+type jsonKey struct {
+	jsonBase
+	WordIDs []string `json:"wordIds"`
+}
+
+type jsonValue struct {
+	jsonBase
+	WordIDs          []string              `json:"wordIds"`
+	SelectionElement *jsonSelectionElement `json:"selectionElement,omitempty"`
+}
+
+type jsonKeyValue struct {
+	Key   *jsonKey   `json:"key"`
+	Value *jsonValue `json:"value"`
+}
+
+type jsonCell struct {
+	jsonBase
+	RowIndex    int                `json:"rowIndex"`
+	ColumnIndex int                `json:"columnIndex"`
+	RowSpan     int                `json:"rowSpan"`
+	ColumnSpan  int                `json:"columnSpan"`
+	EntityTypes []types.EntityType `json:"entityTypes"`
+}
+
+type jsonTableCell struct {
+	jsonCell
+	WordIDs          []string              `json:"wordIds"`
+	SelectionElement *jsonSelectionElement `json:"selectionElement,omitempty"`
+}
+
+type jsonTableMergedCell struct {
+	jsonCell
+	CellIDs []string `json:"cellIds"`
+}
+
+type jsonTableText struct {
+	jsonBase
+	WordIDs []string `json:"wordIds"`
+}
+
+type jsonTable struct {
+	jsonBase
+	Title       *jsonTableText         `json:"title,omitempty"`
+	Footers     []*jsonTableText       `json:"footers"`
+	Cells       []*jsonTableCell       `json:"cells"`
+	MergedCells []*jsonTableMergedCell `json:"mergedCells"`
+}
+
+type jsonLayoutChild struct {
+	Kind   string      `json:"kind"`
+	ID     string      `json:"id,omitempty"`
+	Layout *jsonLayout `json:"layout,omitempty"`
+}
+
+type jsonLayout struct {
+	jsonBase
+	NoNewLines bool               `json:"noNewLines"`
+	Children   []*jsonLayoutChild `json:"children"`
+}
+
+type jsonQueryResult struct {
+	jsonBase
+	Text    string   `json:"text"`
+	WordIDs []string `json:"wordIds,omitempty"`
+}
+
+type jsonQuery struct {
+	ID         string             `json:"id"`
+	Text       string             `json:"text"`
+	Alias      string             `json:"alias"`
+	QueryPages []string           `json:"queryPages"`
+	Results    []*jsonQueryResult `json:"results"`
+	Raw        *types.Block       `json:"raw,omitempty"`
+}
+
+type jsonSignature struct {
+	jsonBase
+}
+
+// MarshalJSON encodes the document into a stable, versioned JSON representation.
+func (d *Document) MarshalJSON() ([]byte, error) {
+	jd := &jsonDocument{
+		Version: DocumentJSONSchemaVersion,
+		Pages:   make([]*jsonPage, len(d.pages)),
+	}
+
+	for i, p := range d.pages {
+		jp, err := newJSONPage(p)
+		if err != nil {
+			return nil, err
+		}
+
+		jd.Pages[i] = jp
+	}
+
+	return json.Marshal(jd)
+}
+
+// UnmarshalJSON decodes a document previously encoded with MarshalJSON, restoring all relationships.
+// The parse report is not part of the JSON representation and is empty after decoding.
+func (d *Document) UnmarshalJSON(data []byte) error {
+	jd := new(jsonDocument)
+	if err := json.Unmarshal(data, jd); err != nil {
+		return err
+	}
+
+	if jd.Version != DocumentJSONSchemaVersion {
+		return fmt.Errorf("unsupported document schema version %d", jd.Version)
+	}
+
+	pages := make([]*Page, len(jd.Pages))
+
+	for i, jp := range jd.Pages {
+		p := new(Page)
+		if err := jp.decodeInto(p); err != nil {
+			return err
+		}
+
+		pages[i] = p
+	}
+
+	d.pages = pages
+	d.report = &ParseReport{}
+
+	return nil
+}
+
+// MarshalJSON encodes the page into a stable, versioned JSON representation.
+func (p *Page) MarshalJSON() ([]byte, error) {
+	jp, err := newJSONPage(p)
+	if err != nil {
+		return nil, err
+	}
+
+	jp.Version = DocumentJSONSchemaVersion
+
+	return json.Marshal(jp)
+}
+
+// UnmarshalJSON decodes a page previously encoded with MarshalJSON, restoring all relationships.
+func (p *Page) UnmarshalJSON(data []byte) error {
+	jp := new(jsonPage)
+	if err := json.Unmarshal(data, jp); err != nil {
+		return err
+	}
+
+	if jp.Version != DocumentJSONSchemaVersion {
+		return fmt.Errorf("unsupported page schema version %d", jp.Version)
+	}
+
+	return jp.decodeInto(p)
+}
+
+func newJSONPage(p *Page) (*jsonPage, error) {
+	jp := &jsonPage{
+		ID:         p.id,
+		Number:     p.number,
+		Width:      p.width,
+		Height:     p.height,
+		ChildIDs:   p.childIDs,
+		Words:      make([]*jsonWord, len(p.words)),
+		Lines:      make([]*jsonLine, len(p.lines)),
+		KeyValues:  make([]*jsonKeyValue, len(p.keyValues)),
+		Tables:     make([]*jsonTable, len(p.tables)),
+		Layouts:    make([]*jsonLayout, len(p.layouts)),
+		Queries:    make([]*jsonQuery, len(p.queries)),
+		Signatures: make([]*jsonSignature, len(p.signatures)),
+	}
+
+	if p.dimensions != nil {
+		jp.Dimensions = &jsonDimensions{
+			Width:  p.dimensions.width,
+			Height: p.dimensions.height,
+			DPI:    p.dimensions.dpi,
+		}
+	}
+
+	for i, w := range p.words {
+		jp.Words[i] = &jsonWord{
+			jsonBase: newJSONBase(&w.base),
+			Text:     w.text,
+			TextType: w.textType,
+		}
+	}
+
+	for i, l := range p.lines {
+		jp.Lines[i] = &jsonLine{
+			jsonBase: newJSONBase(&l.base),
+			WordIDs:  wordIDs(l.words),
+		}
+	}
+
+	for i, kv := range p.keyValues {
+		jkv := &jsonKeyValue{}
+
+		if kv.key != nil {
+			jkv.Key = &jsonKey{
+				jsonBase: newJSONBase(&kv.key.base),
+				WordIDs:  wordIDs(kv.key.words),
+			}
+		}
+
+		if kv.value != nil {
+			jkv.Value = &jsonValue{
+				jsonBase:         newJSONBase(&kv.value.base),
+				WordIDs:          wordIDs(kv.value.words),
+				SelectionElement: newJSONSelectionElement(kv.value.selectionElement),
+			}
+		}
+
+		jp.KeyValues[i] = jkv
+	}
+
+	for i, t := range p.tables {
+		jp.Tables[i] = newJSONTable(t)
+	}
+
+	for i, l := range p.layouts {
+		jl, err := newJSONLayout(l)
+		if err != nil {
+			return nil, err
+		}
+
+		jp.Layouts[i] = jl
+	}
+
+	for i, q := range p.queries {
+		jq := &jsonQuery{
+			ID:         q.id,
+			Text:       q.text,
+			Alias:      q.alias,
+			QueryPages: q.queryPages,
+			Results:    make([]*jsonQueryResult, len(q.results)),
+			Raw:        newJSONRaw(q.raw),
+		}
+
+		for j, r := range q.results {
+			jq.Results[j] = &jsonQueryResult{
+				jsonBase: newJSONBase(&r.base),
+				Text:     r.text,
+				WordIDs:  wordIDs(r.words),
+			}
+		}
+
+		jp.Queries[i] = jq
+	}
+
+	for i, s := range p.signatures {
+		jp.Signatures[i] = &jsonSignature{
+			jsonBase: newJSONBase(&s.base),
+		}
+	}
+
+	return jp, nil
+}
+
+func newJSONBase(b *base) jsonBase {
+	jb := jsonBase{
+		ID:         b.id,
+		Confidence: b.confidence,
+		BlockType:  b.blockType,
+		Raw:        newJSONRaw(b.raw),
+	}
+
+	if b.boundingBox != nil {
+		jb.BoundingBox = &jsonBoundingBox{
+			Height: b.boundingBox.height,
+			Left:   b.boundingBox.left,
+			Top:    b.boundingBox.top,
+			Width:  b.boundingBox.width,
+		}
+	}
+
+	if b.polygon != nil {
+		jb.Polygon = make([]jsonPoint, len(b.polygon))
+		for i, p := range b.polygon {
+			jb.Polygon[i] = jsonPoint{X: p.x, Y: p.y}
+		}
+	}
+
+	return jb
+}
+
+// newJSONRaw returns nil for synthesized elements that have no raw block.
+func newJSONRaw(raw types.Block) *types.Block {
+	if raw.Id == nil && raw.BlockType == "" {
+		return nil
+	}
+
+	return &raw
+}
+
+func newJSONSelectionElement(se *SelectionElement) *jsonSelectionElement {
+	if se == nil {
+		return nil
+	}
+
+	return &jsonSelectionElement{
+		jsonBase: newJSONBase(&se.base),
+		Status:   se.status,
+	}
+}
+
+func newJSONCell(c *cell) jsonCell {
+	return jsonCell{
+		jsonBase:    newJSONBase(&c.base),
+		RowIndex:    c.rowIndex,
+		ColumnIndex: c.columnIndex,
+		RowSpan:     c.rowSpan,
+		ColumnSpan:  c.columnSpan,
+		EntityTypes: c.entityTypes,
+	}
+}
+
+func newJSONTable(t *Table) *jsonTable {
+	jt := &jsonTable{
+		jsonBase:    newJSONBase(&t.base),
+		Footers:     make([]*jsonTableText, len(t.footers)),
+		Cells:       make([]*jsonTableCell, len(t.cells)),
+		MergedCells: make([]*jsonTableMergedCell, len(t.mergedCells)),
+	}
+
+	if t.title != nil {
+		jt.Title = &jsonTableText{
+			jsonBase: newJSONBase(&t.title.base),
+			WordIDs:  wordIDs(t.title.words),
+		}
+	}
+
+	for i, f := range t.footers {
+		jt.Footers[i] = &jsonTableText{
+			jsonBase: newJSONBase(&f.base),
+			WordIDs:  wordIDs(f.words),
+		}
+	}
+
+	for i, c := range t.cells {
+		jt.Cells[i] = &jsonTableCell{
+			jsonCell:         newJSONCell(&c.cell),
+			WordIDs:          wordIDs(c.words),
+			SelectionElement: newJSONSelectionElement(c.selectionElement),
+		}
+	}
+
+	for i, mc := range t.mergedCells {
+		cellIDs := make([]string, len(mc.cells))
+		for j, c := range mc.cells {
+			cellIDs[j] = c.id
+		}
+
+		jt.MergedCells[i] = &jsonTableMergedCell{
+			jsonCell: newJSONCell(&mc.cell),
+			CellIDs:  cellIDs,
+		}
+	}
+
+	return jt
+}
+
+func newJSONLayout(l *Layout) (*jsonLayout, error) {
+	jl := &jsonLayout{
+		jsonBase:   newJSONBase(&l.base),
+		NoNewLines: l.noNewLines,
+		Children:   make([]*jsonLayoutChild, 0, len(l.children)),
+	}
+
+	for _, c := range l.children {
+		var jc *jsonLayoutChild
+
+		switch v := c.(type) {
+		case *Line:
+			if v == nil {
+				continue
+			}
+
+			jc = &jsonLayoutChild{Kind: jsonLayoutChildLine, ID: v.id}
+		case *Layout:
+			if v == nil {
+				continue
+			}
+
+			child, err := newJSONLayout(v)
+			if err != nil {
+				return nil, err
+			}
+
+			jc = &jsonLayoutChild{Kind: jsonLayoutChildLayout, Layout: child}
+		case *Table:
+			jc = &jsonLayoutChild{Kind: jsonLayoutChildTable, ID: v.id}
+		case *KeyValue:
+			// Key-values are referenced by the ID of their key, so those without a key are skipped
+			if v == nil || v.key == nil {
+				continue
+			}
+
+			jc = &jsonLayoutChild{Kind: jsonLayoutChildKeyValue, ID: v.key.id}
+		case *Signature:
+			jc = &jsonLayoutChild{Kind: jsonLayoutChildSignature, ID: v.id}
+		default:
+			return nil, fmt.Errorf("unsupported layout child type %T", c)
+		}
+
+		jl.Children = append(jl.Children, jc)
+	}
+
+	return jl, nil
+}
+
+func wordIDs(words []*Word) []string {
+	if words == nil {
+		return nil
+	}
+
+	ids := make([]string, len(words))
+	for i, w := range words {
+		ids[i] = w.id
+	}
+
+	return ids
+}
+
+// jsonPageDecoder restores a page and the relationships between its elements.
+type jsonPageDecoder struct {
+	page       *Page
+	idWordMap  map[string]*Word
+	idLineMap  map[string]*Line
+	idTableMap map[string]*Table
+	idKVMap    map[string]*KeyValue
+	idSigMap   map[string]*Signature
+}
+
+// decodeInto populates the given page, so that all elements reference it.
+func (jp *jsonPage) decodeInto(page *Page) error {
+	*page = Page{
+		id:       jp.ID,
+		number:   jp.Number,
+		width:    jp.Width,
+		height:   jp.Height,
+		childIDs: jp.ChildIDs,
+	}
+
+	if jp.Dimensions != nil {
+		dimensions, err := NewPageDimensionsFromPDF(jp.Dimensions.Width, jp.Dimensions.Height, jp.Dimensions.DPI)
+		if err != nil {
+			return fmt.Errorf("failed to decode page %d: %w", jp.Number, err)
+		}
+
+		page.dimensions = dimensions
+	}
+
+	dec := &jsonPageDecoder{
+		page:       page,
+		idWordMap:  make(map[string]*Word, len(jp.Words)),
+		idLineMap:  make(map[string]*Line, len(jp.Lines)),
+		idTableMap: make(map[string]*Table, len(jp.Tables)),
+		idKVMap:    make(map[string]*KeyValue, len(jp.KeyValues)),
+		idSigMap:   make(map[string]*Signature, len(jp.Signatures)),
+	}
+
+	return dec.decode(jp)
+}
+
+func (dec *jsonPageDecoder) decode(jp *jsonPage) error {
+	page := dec.page
+
+	page.words = make([]*Word, len(jp.Words))
+	for i, jw := range jp.Words {
+		w := &Word{
+			base:     dec.base(jw.jsonBase),
+			text:     jw.Text,
+			textType: jw.TextType,
+		}
+
+		dec.idWordMap[w.id] = w
+		page.words[i] = w
+	}
+
+	page.lines = make([]*Line, len(jp.Lines))
+	for i, jl := range jp.Lines {
+		words, err := dec.words(jl.WordIDs)
+		if err != nil {
+			return err
+		}
+
+		l := &Line{
+			base:  dec.base(jl.jsonBase),
+			words: words,
+		}
+
+		for _, w := range words {
+			w.line = l
+		}
+
+		dec.idLineMap[l.id] = l
+		page.lines[i] = l
+	}
+
+	page.keyValues = make([]*KeyValue, len(jp.KeyValues))
+	for i, jkv := range jp.KeyValues {
+		kv, err := dec.keyValue(jkv)
+		if err != nil {
+			return err
+		}
+
+		if kv.key != nil {
+			dec.idKVMap[kv.key.id] = kv
+		}
+
+		page.keyValues[i] = kv
+	}
+
+	page.tables = make([]*Table, len(jp.Tables))
+	for i, jt := range jp.Tables {
+		t, err := dec.table(jt)
+		if err != nil {
+			return err
+		}
+
+		dec.idTableMap[t.id] = t
+		page.tables[i] = t
+	}
+
+	page.signatures = make([]*Signature, len(jp.Signatures))
+	for i, js := range jp.Signatures {
+		s := &Signature{
+			base: dec.base(js.jsonBase),
+		}
+
+		dec.idSigMap[s.id] = s
+		page.signatures[i] = s
+	}
+
+	page.layouts = make([]*Layout, len(jp.Layouts))
+	for i, jl := range jp.Layouts {
+		l, err := dec.layout(jl)
+		if err != nil {
+			return err
+		}
+
+		page.layouts[i] = l
+	}
+
+	page.queries = make([]*Query, len(jp.Queries))
+	for i, jq := range jp.Queries {
+		q := &Query{
+			id:         jq.ID,
+			text:       jq.Text,
+			alias:      jq.Alias,
+			queryPages: jq.QueryPages,
+			results:    make([]*QueryResult, len(jq.Results)),
+			page:       page,
+			raw:        rawFromJSON(jq.Raw),
+		}
+
+		for j, jr := range jq.Results {
+			words, err := dec.words(jr.WordIDs)
+			if err != nil {
+				return err
+			}
+
+			q.results[j] = &QueryResult{
+				base:  dec.base(jr.jsonBase),
+				text:  jr.Text,
+				words: words,
+			}
+		}
+
+		page.queries[i] = q
+	}
+
+	return nil
+}
+
+func (dec *jsonPageDecoder) base(jb jsonBase) base {
+	b := base{
+		id:         jb.ID,
+		confidence: jb.Confidence,
+		blockType:  jb.BlockType,
+		page:       dec.page,
+		raw:        rawFromJSON(jb.Raw),
+	}
+
+	if jb.BoundingBox != nil {
+		b.boundingBox = &BoundingBox{
+			height: jb.BoundingBox.Height,
+			left:   jb.BoundingBox.Left,
+			top:    jb.BoundingBox.Top,
+			width:  jb.BoundingBox.Width,
+		}
+	}
+
+	if jb.Polygon != nil {
+		b.polygon = make(Polygon, len(jb.Polygon))
+		for i, p := range jb.Polygon {
+			b.polygon[i] = &Point{x: p.X, y: p.Y}
+		}
+	}
+
+	return b
+}
+
+func (dec *jsonPageDecoder) words(ids []string) ([]*Word, error) {
+	if ids == nil {
+		return nil, nil
+	}
+
+	words := make([]*Word, len(ids))
+
+	for i, id := range ids {
+		w, ok := dec.idWordMap[id]
+		if !ok {
+			return nil, fmt.Errorf("dangling word id %s on page %d", id, dec.page.number)
+		}
+
+		words[i] = w
+	}
+
+	return words, nil
+}
+
+func (dec *jsonPageDecoder) selectionElement(jse *jsonSelectionElement) *SelectionElement {
+	if jse == nil {
+		return nil
+	}
+
+	return &SelectionElement{
+		base:   dec.base(jse.jsonBase),
+		status: jse.Status,
+	}
+}
+
+func (dec *jsonPageDecoder) keyValue(jkv *jsonKeyValue) (*KeyValue, error) {
+	kv := &KeyValue{
+		page: dec.page,
+	}
+
+	if jkv.Key != nil {
+		words, err := dec.words(jkv.Key.WordIDs)
+		if err != nil {
+			return nil, err
+		}
+
+		kv.key = &Key{
+			base:  dec.base(jkv.Key.jsonBase),
+			words: words,
+		}
+	}
+
+	if jkv.Value != nil {
+		words, err := dec.words(jkv.Value.WordIDs)
+		if err != nil {
+			return nil, err
+		}
+
+		kv.value = &Value{
+			base:             dec.base(jkv.Value.jsonBase),
+			words:            words,
+			selectionElement: dec.selectionElement(jkv.Value.SelectionElement),
+		}
+	}
+
+	return kv, nil
+}
+
+func (dec *jsonPageDecoder) cell(jc jsonCell) cell {
+	return cell{
+		base:        dec.base(jc.jsonBase),
+		rowIndex:    jc.RowIndex,
+		columnIndex: jc.ColumnIndex,
+		rowSpan:     jc.RowSpan,
+		columnSpan:  jc.ColumnSpan,
+		entityTypes: jc.EntityTypes,
+	}
+}
+
+func (dec *jsonPageDecoder) table(jt *jsonTable) (*Table, error) {
+	t := &Table{
+		base: dec.base(jt.jsonBase),
+	}
+
+	if jt.Title != nil {
+		words, err := dec.words(jt.Title.WordIDs)
+		if err != nil {
+			return nil, err
+		}
+
+		t.title = &TableTitle{
+			base:  dec.base(jt.Title.jsonBase),
+			words: words,
+		}
+	}
+
+	for _, jf := range jt.Footers {
+		words, err := dec.words(jf.WordIDs)
+		if err != nil {
+			return nil, err
+		}
+
+		t.footers = append(t.footers, &TableFooter{
+			base:  dec.base(jf.jsonBase),
+			words: words,
+		})
+	}
+
+	idCellMap := make(map[string]*TableCell, len(jt.Cells))
+
+	for _, jc := range jt.Cells {
+		words, err := dec.words(jc.WordIDs)
+		if err != nil {
+			return nil, err
+		}
+
+		c := &TableCell{
+			cell:             dec.cell(jc.jsonCell),
+			words:            words,
+			selectionElement: dec.selectionElement(jc.SelectionElement),
+		}
+
+		for _, w := range words {
+			w.tableCell = c
+		}
+
+		idCellMap[c.id] = c
+		t.cells = append(t.cells, c)
+	}
+
+	for _, jmc := range jt.MergedCells {
+		mc := &TableMergedCell{
+			cell:  dec.cell(jmc.jsonCell),
+			cells: make([]*TableCell, len(jmc.CellIDs)),
+		}
+
+		for i, id := range jmc.CellIDs {
+			c, ok := idCellMap[id]
+			if !ok {
+				return nil, fmt.Errorf("dangling cell id %s in table %s", id, t.id)
+			}
+
+			mc.cells[i] = c
+		}
+
+		t.mergedCells = append(t.mergedCells, mc)
+	}
+
+	return t, nil
+}
+
+func (dec *jsonPageDecoder) layout(jl *jsonLayout) (*Layout, error) {
+	l := &Layout{
+		base:       dec.base(jl.jsonBase),
+		noNewLines: jl.NoNewLines,
+	}
+
+	for _, jc := range jl.Children {
+		var (
+			child LayoutChild
+			ok    bool
+		)
+
+		switch jc.Kind {
+		case jsonLayoutChildLine:
+			child, ok = dec.idLineMap[jc.ID]
+		case jsonLayoutChildTable:
+			child, ok = dec.idTableMap[jc.ID]
+		case jsonLayoutChildKeyValue:
+			child, ok = dec.idKVMap[jc.ID]
+		case jsonLayoutChildSignature:
+			child, ok = dec.idSigMap[jc.ID]
+		case jsonLayoutChildLayout:
+			if jc.Layout == nil {
+				return nil, fmt.Errorf("missing nested layout in layout %s", l.id)
+			}
+
+			nested, err := dec.layout(jc.Layout)
+			if err != nil {
+				return nil, err
+			}
+
+			child, ok = nested, true
+		default:
+			return nil, fmt.Errorf("unknown layout child kind %s in layout %s", jc.Kind, l.id)
+		}
+
+		if !ok {
+			return nil, fmt.Errorf("dangling %s id %s in layout %s", jc.Kind, jc.ID, l.id)
+		}
+
+		l.children = append(l.children, child)
+	}
+
+	return l, nil
+}
+
+func rawFromJSON(raw *types.Block) types.Block {
+	if raw == nil {
+		return types.Block{}
+	}
+
+	return *raw
+}
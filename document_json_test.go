@@ -0,0 +1,118 @@
+package textractor
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDocumentJSON(t *testing.T) {
+	testCases := []string{
+		"testdata/test-document.json",
+		"testdata/test-layout.json",
+		"testdata/test-simple-table-layout.json",
+		"testdata/test-response-for-llm.json",
+		"testdata/test-response.json",
+	}
+
+	for _, filename := range testCases {
+		t.Run(filename, func(t *testing.T) {
+			res, err := loadDocumentAPIOutputTestdata(filename)
+			assert.NoError(t, err)
+
+			doc, err := ParseDocumentAPIOutput(res)
+			assert.NoError(t, err)
+
+			data, err := json.Marshal(doc)
+			assert.NoError(t, err)
+
+			decoded := new(Document)
+			assert.NoError(t, json.Unmarshal(data, decoded))
+
+			assert.True(t, assert.ObjectsAreEqual(doc, decoded), "decoded document differs from original")
+			assert.Equal(t, doc.Text(), decoded.Text())
+
+			// Relationships must point to the decoded elements.
+			for _, p := range decoded.Pages() {
+				for _, w := range p.Words() {
+					assert.Same(t, p, w.page)
+
+					if w.line != nil {
+						assert.Contains(t, w.line.Words(), w)
+					}
+
+					if w.tableCell != nil {
+						assert.Contains(t, w.tableCell.Words(), w)
+					}
+				}
+			}
+
+			// Encoding must be stable.
+			again, err := json.Marshal(decoded)
+			assert.NoError(t, err)
+			assert.JSONEq(t, string(data), string(again))
+		})
+	}
+
+	t.Run("Page", func(t *testing.T) {
+		res, err := loadDocumentAPIOutputTestdata("testdata/test-document.json")
+		assert.NoError(t, err)
+
+		doc, err := ParseDocumentAPIOutput(res)
+		assert.NoError(t, err)
+
+		data, err := json.Marshal(doc.Pages()[0])
+		assert.NoError(t, err)
+
+		page := new(Page)
+		assert.NoError(t, json.Unmarshal(data, page))
+		assert.Equal(t, doc.Pages()[0].Text(), page.Text())
+		assert.Same(t, page, page.Words()[0].page)
+	})
+
+	t.Run("Dimensions", func(t *testing.T) {
+		res, err := loadDocumentAPIOutputTestdata("testdata/test-document.json")
+		assert.NoError(t, err)
+
+		doc, err := ParseDocumentAPIOutput(res)
+		assert.NoError(t, err)
+
+		dimensions, err := NewPageDimensionsFromPDF(612, 792, 150)
+		assert.NoError(t, err)
+
+		doc.SetDimensions(dimensions)
+
+		data, err := json.Marshal(doc)
+		assert.NoError(t, err)
+
+		decoded := new(Document)
+		assert.NoError(t, json.Unmarshal(data, decoded))
+		assert.Equal(t, doc.Pages()[0].Dimensions(), decoded.Pages()[0].Dimensions())
+	})
+
+	t.Run("KeyValueWithoutKey", func(t *testing.T) {
+		page := &Page{id: "p1"}
+		kv := &KeyValue{value: &Value{base: base{id: "value", page: page}}, page: page}
+		page.keyValues = []*KeyValue{kv}
+		page.layouts = []*Layout{{base: base{id: "layout", blockType: "LAYOUT_KEY_VALUE", page: page}, children: []LayoutChild{kv}}}
+
+		data, err := json.Marshal(page)
+		assert.NoError(t, err)
+
+		decoded := new(Page)
+		assert.NoError(t, json.Unmarshal(data, decoded))
+		assert.Len(t, decoded.KeyValues(), 1)
+		assert.Empty(t, decoded.Layouts()[0].children)
+	})
+
+	t.Run("UnsupportedVersion", func(t *testing.T) {
+		err := json.Unmarshal([]byte(`{"version":99,"pages":[]}`), new(Document))
+		assert.Error(t, err)
+	})
+
+	t.Run("DanglingWordID", func(t *testing.T) {
+		err := json.Unmarshal([]byte(`{"version":1,"pages":[{"id":"p1","lines":[{"id":"l1","wordIds":["missing"]}]}]}`), new(Document))
+		assert.ErrorContains(t, err, "dangling word id missing")
+	})
+}
@@ -36,3 +36,110 @@ const (
 	IdentityDocumentTypePassport           IdentityDocumentType = "PASSPORT"
 	IdentityDocumentTypeOther              IdentityDocumentType = "OTHER"
 )
+
+// ExpenseFieldType represents the normalized type of a field in an expense document.
+type ExpenseFieldType string
+
+const (
+	ExpenseFieldTypeAddress                ExpenseFieldType = "ADDRESS"
+	ExpenseFieldTypeAddressBlock           ExpenseFieldType = "ADDRESS_BLOCK"
+	ExpenseFieldTypeAmountDue              ExpenseFieldType = "AMOUNT_DUE"
+	ExpenseFieldTypeAmountPaid             ExpenseFieldType = "AMOUNT_PAID"
+	ExpenseFieldTypeCity                   ExpenseFieldType = "CITY"
+	ExpenseFieldTypeCountry                ExpenseFieldType = "COUNTRY"
+	ExpenseFieldTypeCustomerNumber         ExpenseFieldType = "CUSTOMER_NUMBER"
+	ExpenseFieldTypeDeliveryDate           ExpenseFieldType = "DELIVERY_DATE"
+	ExpenseFieldTypeDiscount               ExpenseFieldType = "DISCOUNT"
+	ExpenseFieldTypeDueDate                ExpenseFieldType = "DUE_DATE"
+	ExpenseFieldTypeGratuity               ExpenseFieldType = "GRATUITY"
+	ExpenseFieldTypeInvoiceReceiptDate     ExpenseFieldType = "INVOICE_RECEIPT_DATE"
+	ExpenseFieldTypeInvoiceReceiptID       ExpenseFieldType = "INVOICE_RECEIPT_ID"
+	ExpenseFieldTypeName                   ExpenseFieldType = "NAME"
+	ExpenseFieldTypeOrderDate              ExpenseFieldType = "ORDER_DATE"
+	ExpenseFieldTypePaymentTerms           ExpenseFieldType = "PAYMENT_TERMS"
+	ExpenseFieldTypePONumber               ExpenseFieldType = "PO_NUMBER"
+	ExpenseFieldTypePriorBalance           ExpenseFieldType = "PRIOR_BALANCE"
+	ExpenseFieldTypeReceiverAddress        ExpenseFieldType = "RECEIVER_ADDRESS"
+	ExpenseFieldTypeReceiverName           ExpenseFieldType = "RECEIVER_NAME"
+	ExpenseFieldTypeReceiverPhone          ExpenseFieldType = "RECEIVER_PHONE"
+	ExpenseFieldTypeServiceCharge          ExpenseFieldType = "SERVICE_CHARGE"
+	ExpenseFieldTypeShippingHandlingCharge ExpenseFieldType = "SHIPPING_HANDLING_CHARGE"
+	ExpenseFieldTypeState                  ExpenseFieldType = "STATE"
+	ExpenseFieldTypeStreet                 ExpenseFieldType = "STREET"
+	ExpenseFieldTypeSubtotal               ExpenseFieldType = "SUBTOTAL"
+	ExpenseFieldTypeTax                    ExpenseFieldType = "TAX"
+	ExpenseFieldTypeTaxPayerID             ExpenseFieldType = "TAX_PAYER_ID"
+	ExpenseFieldTypeTotal                  ExpenseFieldType = "TOTAL"
+	ExpenseFieldTypeVendorAddress          ExpenseFieldType = "VENDOR_ADDRESS"
+	ExpenseFieldTypeVendorName             ExpenseFieldType = "VENDOR_NAME"
+	ExpenseFieldTypeVendorPhone            ExpenseFieldType = "VENDOR_PHONE"
+	ExpenseFieldTypeVendorURL              ExpenseFieldType = "VENDOR_URL"
+	ExpenseFieldTypeZipCode                ExpenseFieldType = "ZIP_CODE"
+	ExpenseFieldTypeItem                   ExpenseFieldType = "ITEM"
+	ExpenseFieldTypePrice                  ExpenseFieldType = "PRICE"
+	ExpenseFieldTypeProductCode            ExpenseFieldType = "PRODUCT_CODE"
+	ExpenseFieldTypeQuantity               ExpenseFieldType = "QUANTITY"
+	ExpenseFieldTypeUnitPrice              ExpenseFieldType = "UNIT_PRICE"
+	ExpenseFieldTypeExpenseRow             ExpenseFieldType = "EXPENSE_ROW"
+	ExpenseFieldTypeOther                  ExpenseFieldType = "OTHER"
+)
+
+// LendingPageTypeUnknown is the page type of lending pages that could not be classified.
+const LendingPageTypeUnknown = "UNKNOWN"
+
+// ReadingOrder defines the order in which the layouts of a page are linearized.
+type ReadingOrder string
+
+const (
+	// ReadingOrderTopToBottom orders the layouts by their top coordinate.
+	ReadingOrderTopToBottom ReadingOrder = "TOP_TO_BOTTOM"
+	// ReadingOrderNative keeps the order in which Textract returned the layouts.
+	ReadingOrderNative ReadingOrder = "NATIVE"
+	// ReadingOrderColumns detects columns with a recursive XY-cut and reads each column from top to bottom.
+	ReadingOrderColumns ReadingOrder = "COLUMNS"
+)
+
+// OverlayElement represents a type of element drawn by the overlay renderer.
+type OverlayElement string
+
+const (
+	OverlayElementWord      OverlayElement = "WORD"
+	OverlayElementLine      OverlayElement = "LINE"
+	OverlayElementLayout    OverlayElement = "LAYOUT"
+	OverlayElementTable     OverlayElement = "TABLE"
+	OverlayElementCell      OverlayElement = "CELL"
+	OverlayElementKeyValue  OverlayElement = "KEY_VALUE"
+	OverlayElementSignature OverlayElement = "SIGNATURE"
+)
+
+// Direction represents a direction on a page.
+type Direction string
+
+const (
+	DirectionLeft  Direction = "LEFT"
+	DirectionRight Direction = "RIGHT"
+	DirectionUp    Direction = "UP"
+	DirectionDown  Direction = "DOWN"
+)
+
+// TypedValueType represents the type of a normalized value.
+type TypedValueType string
+
+const (
+	TypedValueTypeText        TypedValueType = "TEXT"
+	TypedValueTypeDate        TypedValueType = "DATE"
+	TypedValueTypeMoney       TypedValueType = "MONEY"
+	TypedValueTypePercentage  TypedValueType = "PERCENTAGE"
+	TypedValueTypeNumber      TypedValueType = "NUMBER"
+	TypedValueTypePhoneNumber TypedValueType = "PHONE_NUMBER"
+	TypedValueTypeCheckbox    TypedValueType = "CHECKBOX"
+)
+
+// Unit represents a unit of page coordinates.
+type Unit string
+
+const (
+	UnitNormalized Unit = "NORMALIZED"
+	UnitPixel      Unit = "PIXEL"
+	UnitPoint      Unit = "POINT"
+)
@@ -0,0 +1,96 @@
+package textractor
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/textract/types"
+)
+
+// InvalidBlockError is reported when a block lacks a field required to parse it.
+type InvalidBlockError struct {
+	BlockID   string
+	BlockType types.BlockType
+	Reason    string
+}
+
+// Error returns the error message.
+func (e *InvalidBlockError) Error() string {
+	return fmt.Sprintf("invalid %s block %q: %s", e.BlockType, e.BlockID, e.Reason)
+}
+
+// MissingRelationshipError is reported when a block lacks a relationship required to parse it.
+type MissingRelationshipError struct {
+	BlockID          string
+	BlockType        types.BlockType
+	RelationshipType types.RelationshipType
+}
+
+// Error returns the error message.
+func (e *MissingRelationshipError) Error() string {
+	return fmt.Sprintf("%s block %q has no %s relationship", e.BlockType, e.BlockID, e.RelationshipType)
+}
+
+// DanglingBlockIDError is reported when a relationship references a block that is not part of the response.
+type DanglingBlockIDError struct {
+	BlockID          string
+	BlockType        types.BlockType
+	RelationshipType types.RelationshipType
+	ReferencedID     string
+}
+
+// Error returns the error message.
+func (e *DanglingBlockIDError) Error() string {
+	return fmt.Sprintf("%s relationship of %s block %q references unknown block %q", e.RelationshipType, e.BlockType, e.BlockID, e.ReferencedID)
+}
+
+// PageCountMismatchError is reported when the number of parsed pages does not match the document metadata.
+type PageCountMismatchError struct {
+	Pages         int
+	MetadataPages int
+}
+
+// Error returns the error message.
+func (e *PageCountMismatchError) Error() string {
+	return fmt.Sprintf("number of pages %d does not match metadata %d", e.Pages, e.MetadataPages)
+}
+
+// checkPageCount returns a PageCountMismatchError if the number of pages does not match the metadata.
+func checkPageCount(pages int, metadata *types.DocumentMetadata) error {
+	if metadata == nil || metadata.Pages == nil {
+		return nil
+	}
+
+	if pages != int(aws.ToInt32(metadata.Pages)) {
+		return &PageCountMismatchError{Pages: pages, MetadataPages: int(aws.ToInt32(metadata.Pages))}
+	}
+
+	return nil
+}
+
+// ParseReport collects the problems found while parsing a Textract response. Blocks affected
+// by a problem are skipped.
+type ParseReport struct {
+	warnings []error
+}
+
+// Warnings returns the problems found while parsing.
+func (r *ParseReport) Warnings() []error {
+	return r.warnings
+}
+
+// HasWarnings checks if any problems were found while parsing.
+func (r *ParseReport) HasWarnings() bool {
+	return len(r.warnings) > 0
+}
+
+// Err returns all problems joined into a single error, or nil if no problems were found.
+func (r *ParseReport) Err() error {
+	return errors.Join(r.warnings...)
+}
+
+// add records a problem.
+func (r *ParseReport) add(err error) {
+	r.warnings = append(r.warnings, err)
+}
@@ -55,4 +55,11 @@ func main() {
 		tlo.SignatureToken = "[SIGNATURE]"
 	}))
 
+	chunks := doc.Chunks(func(o *textractor.ChunkOptions) {
+		o.MaxCharacters = 1000
+	})
+
+	for _, c := range chunks {
+		fmt.Printf("Chunk %d (pages: %v, headings: %v)\n%s\n\n", c.Index(), c.PageNumbers(), c.HeadingPath(), c.Text())
+	}
 }
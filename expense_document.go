@@ -1,10 +1,113 @@
 package textractor
 
+// ExpenseDocument represents an invoice or receipt extracted by Textract.
 type ExpenseDocument struct {
-	document     *Document
-	summaryField []*ExpenseField
+	index          int
+	document       *Document
+	summaryFields  []*ExpenseField
+	lineItemGroups []*LineItemGroup
+	report         *ParseReport
 }
 
+// ParseReport returns the problems found while parsing the response. It is shared by all documents of the response.
+func (ed *ExpenseDocument) ParseReport() *ParseReport {
+	if ed.report == nil {
+		return &ParseReport{}
+	}
+
+	return ed.report
+}
+
+// Index returns the index of the expense document within the analyzed file, starting at 1.
+func (ed *ExpenseDocument) Index() int {
+	return ed.index
+}
+
+// Document returns the block document of the expense document.
+func (ed *ExpenseDocument) Document() *Document {
+	return ed.document
+}
+
+// SummaryFields returns the fields found outside of line item tables.
 func (ed *ExpenseDocument) SummaryFields() []*ExpenseField {
-	return ed.summaryField
+	return ed.summaryFields
+}
+
+// SummaryFieldByType returns the first summary field of the given type, or nil if there is none.
+func (ed *ExpenseDocument) SummaryFieldByType(ft ExpenseFieldType) *ExpenseField {
+	for _, f := range ed.summaryFields {
+		if f.FieldType() == ft {
+			return f
+		}
+	}
+
+	return nil
+}
+
+// SummaryFieldsByType returns all summary fields of the given type.
+func (ed *ExpenseDocument) SummaryFieldsByType(ft ExpenseFieldType) []*ExpenseField {
+	var fields []*ExpenseField
+
+	for _, f := range ed.summaryFields {
+		if f.FieldType() == ft {
+			fields = append(fields, f)
+		}
+	}
+
+	return fields
+}
+
+// SummaryFieldsByGroupType returns all summary fields belonging to a group of the given type (e.g. VENDOR).
+func (ed *ExpenseDocument) SummaryFieldsByGroupType(groupType string) []*ExpenseField {
+	var fields []*ExpenseField
+
+	for _, f := range ed.summaryFields {
+		if f.HasGroupType(groupType) {
+			fields = append(fields, f)
+		}
+	}
+
+	return fields
+}
+
+// LineItemGroups returns the line item groups (tables) of the expense document.
+func (ed *ExpenseDocument) LineItemGroups() []*LineItemGroup {
+	return ed.lineItemGroups
+}
+
+// LineItemGroup represents a table of line items in an expense document.
+type LineItemGroup struct {
+	index     int
+	lineItems []*LineItem
+}
+
+// Index returns the index of the line item group, starting at 1.
+func (lig *LineItemGroup) Index() int {
+	return lig.index
+}
+
+// LineItems returns the line items (rows) of the group.
+func (lig *LineItemGroup) LineItems() []*LineItem {
+	return lig.lineItems
+}
+
+// LineItem represents a single row of a line item group.
+type LineItem struct {
+	fields []*ExpenseField
+}
+
+// Fields returns the fields of the line item.
+func (li *LineItem) Fields() []*ExpenseField {
+	return li.fields
+}
+
+// FieldByType returns the first field of the given type, or nil if there is none.
+func (li *LineItem) FieldByType(ft ExpenseFieldType) *ExpenseField {
+	for _, f := range li.fields {
+		if f.FieldType() == ft {
+			return f
+		}
+	}
+
+	return nil
 }
@@ -1,20 +1,32 @@
 package textractor
 
-import "github.com/aws/aws-sdk-go-v2/service/textract/types"
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/textract/types"
+)
 
 type expenseDocumentParser struct {
-	blocks []types.Block
+	index          int
+	blocks         []types.Block
+	summaryFields  []types.ExpenseField
+	lineItemGroups []types.LineItemGroup
 }
 
-func newExpenseDocumentParser(identityDocument types.ExpenseDocument) *expenseDocumentParser {
+func newExpenseDocumentParser(expenseDocument types.ExpenseDocument) *expenseDocumentParser {
 	return &expenseDocumentParser{
-		blocks: identityDocument.Blocks,
+		index:          int(aws.ToInt32(expenseDocument.ExpenseIndex)),
+		blocks:         expenseDocument.Blocks,
+		summaryFields:  expenseDocument.SummaryFields,
+		lineItemGroups: expenseDocument.LineItemGroups,
 	}
 }
 
 func (edp *expenseDocumentParser) createExpenseDocument() *ExpenseDocument {
 	return &ExpenseDocument{
-		document: edp.createDocument(),
+		index:          edp.index,
+		document:       edp.createDocument(),
+		summaryFields:  edp.createSummaryFields(),
+		lineItemGroups: edp.createLineItemGroups(),
 	}
 }
 
@@ -22,3 +34,40 @@ func (edp *expenseDocumentParser) createDocument() *Document {
 	parser := newBlockParser(edp.blocks)
 	return parser.createDocument()
 }
+
+func (edp *expenseDocumentParser) createSummaryFields() []*ExpenseField {
+	fields := make([]*ExpenseField, len(edp.summaryFields))
+
+	for i, f := range edp.summaryFields {
+		fields[i] = newExpenseField(f)
+	}
+
+	return fields
+}
+
+func (edp *expenseDocumentParser) createLineItemGroups() []*LineItemGroup {
+	groups := make([]*LineItemGroup, len(edp.lineItemGroups))
+
+	for i, g := range edp.lineItemGroups {
+		lineItems := make([]*LineItem, len(g.LineItems))
+
+		for j, li := range g.LineItems {
+			fields := make([]*ExpenseField, len(li.LineItemExpenseFields))
+
+			for k, f := range li.LineItemExpenseFields {
+				fields[k] = newExpenseField(f)
+			}
+
+			lineItems[j] = &LineItem{
+				fields: fields,
+			}
+		}
+
+		groups[i] = &LineItemGroup{
+			index:     int(aws.ToInt32(g.LineItemGroupIndex)),
+			lineItems: lineItems,
+		}
+	}
+
+	return groups
+}
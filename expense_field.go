@@ -1,3 +1,205 @@
 package textractor
 
-type ExpenseField struct{}
+import (
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/textract/types"
+)
+
+// ExpenseField represents a field extracted from an expense document by Textract.
+type ExpenseField struct {
+	fieldType       ExpenseFieldType
+	typeConfidence  float64
+	label           *ExpenseDetection
+	value           *ExpenseDetection
+	currency        *ExpenseCurrency
+	groupProperties []*ExpenseGroupProperty
+	pageNumber      int
+	raw             types.ExpenseField
+}
+
+// newExpenseField creates a new ExpenseField instance from the provided Textract expense field.
+func newExpenseField(f types.ExpenseField) *ExpenseField {
+	field := &ExpenseField{
+		fieldType:  ExpenseFieldTypeOther,
+		label:      newExpenseDetection(f.LabelDetection),
+		value:      newExpenseDetection(f.ValueDetection),
+		pageNumber: int(aws.ToInt32(f.PageNumber)),
+		raw:        f,
+	}
+
+	if f.Type != nil {
+		if t := aws.ToString(f.Type.Text); t != "" {
+			field.fieldType = ExpenseFieldType(t)
+		}
+
+		field.typeConfidence = float64(aws.ToFloat32(f.Type.Confidence))
+	}
+
+	if f.Currency != nil {
+		field.currency = &ExpenseCurrency{
+			code:       aws.ToString(f.Currency.Code),
+			confidence: float64(aws.ToFloat32(f.Currency.Confidence)),
+		}
+	}
+
+	field.groupProperties = make([]*ExpenseGroupProperty, len(f.GroupProperties))
+	for i, gp := range f.GroupProperties {
+		field.groupProperties[i] = &ExpenseGroupProperty{
+			id:    aws.ToString(gp.Id),
+			types: gp.Types,
+		}
+	}
+
+	return field
+}
+
+// FieldType returns the normalized type of the expense field (e.g. TOTAL).
+func (ef *ExpenseField) FieldType() ExpenseFieldType {
+	return ef.fieldType
+}
+
+// TypeConfidence returns the confidence score associated with the field type.
+func (ef *ExpenseField) TypeConfidence() float64 {
+	return ef.typeConfidence
+}
+
+// Label returns the explicitly stated label of the field, if any.
+func (ef *ExpenseField) Label() *ExpenseDetection {
+	return ef.label
+}
+
+// Value returns the detected value of the field.
+func (ef *ExpenseField) Value() *ExpenseDetection {
+	return ef.value
+}
+
+// Currency returns the currency of a monetary value, if any.
+func (ef *ExpenseField) Currency() *ExpenseCurrency {
+	return ef.currency
+}
+
+// GroupProperties returns the groups the field belongs to.
+func (ef *ExpenseField) GroupProperties() []*ExpenseGroupProperty {
+	return ef.groupProperties
+}
+
+// HasGroupType checks if the field belongs to a group of the given type (e.g. VENDOR).
+func (ef *ExpenseField) HasGroupType(groupType string) bool {
+	for _, gp := range ef.groupProperties {
+		if gp.HasType(groupType) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// PageNumber returns the page number the field was detected on.
+func (ef *ExpenseField) PageNumber() int {
+	return ef.pageNumber
+}
+
+// Raw returns the raw expense field data.
+func (ef *ExpenseField) Raw() types.ExpenseField {
+	return ef.raw
+}
+
+// String returns the string representation of the expense field.
+func (ef *ExpenseField) String() string {
+	if ef.value == nil {
+		return string(ef.fieldType)
+	}
+
+	return string(ef.fieldType) + ": " + ef.value.Text()
+}
+
+// ExpenseDetection represents a label or value detected in an expense document.
+type ExpenseDetection struct {
+	text        string
+	confidence  float64
+	boundingBox *BoundingBox
+	polygon     Polygon
+}
+
+// newExpenseDetection creates a new ExpenseDetection instance from the provided Textract expense detection.
+func newExpenseDetection(d *types.ExpenseDetection) *ExpenseDetection {
+	if d == nil {
+		return nil
+	}
+
+	detection := &ExpenseDetection{
+		text:       aws.ToString(d.Text),
+		confidence: float64(aws.ToFloat32(d.Confidence)),
+	}
+
+	if d.Geometry != nil {
+		detection.boundingBox = newBoundingBox(d.Geometry.BoundingBox)
+		detection.polygon = newPolygon(d.Geometry.Polygon)
+	}
+
+	return detection
+}
+
+// Text returns the detected text.
+func (ed *ExpenseDetection) Text() string {
+	return ed.text
+}
+
+// Confidence returns the confidence score of the detection.
+func (ed *ExpenseDetection) Confidence() float64 {
+	return ed.confidence
+}
+
+// BoundingBox returns the bounding box of the detection.
+func (ed *ExpenseDetection) BoundingBox() *BoundingBox {
+	return ed.boundingBox
+}
+
+// Polygon returns the polygon of the detection.
+func (ed *ExpenseDetection) Polygon() Polygon {
+	return ed.polygon
+}
+
+// String returns the string representation of the detection.
+func (ed *ExpenseDetection) String() string {
+	return ed.Text()
+}
+
+// ExpenseCurrency represents the currency of a monetary value in an expense document.
+type ExpenseCurrency struct {
+	code       string
+	confidence float64
+}
+
+// Code returns the currency code (e.g. USD).
+func (ec *ExpenseCurrency) Code() string {
+	return ec.code
+}
+
+// Confidence returns the confidence score of the detected currency.
+func (ec *ExpenseCurrency) Confidence() float64 {
+	return ec.confidence
+}
+
+// ExpenseGroupProperty represents a group (e.g. the vendor address) an expense field belongs to.
+type ExpenseGroupProperty struct {
+	id    string
+	types []string
+}
+
+// ID returns the group identifier, which is the same for all fields in the group.
+func (egp *ExpenseGroupProperty) ID() string {
+	return egp.id
+}
+
+// Types returns the types of the group (e.g. VENDOR, ADDRESS).
+func (egp *ExpenseGroupProperty) Types() []string {
+	return egp.types
+}
+
+// HasType checks if the group is of the given type.
+func (egp *ExpenseGroupProperty) HasType(groupType string) bool {
+	return slices.Contains(egp.types, groupType)
+}
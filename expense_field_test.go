@@ -0,0 +1,66 @@
+package textractor
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/textract/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpenseField(t *testing.T) {
+	t.Run("AllDetections", func(t *testing.T) {
+		rawField := types.ExpenseField{
+			Type: &types.ExpenseType{
+				Text:       aws.String("TOTAL"),
+				Confidence: aws.Float32(99),
+			},
+			LabelDetection: &types.ExpenseDetection{
+				Text:       aws.String("Total"),
+				Confidence: aws.Float32(95),
+			},
+			ValueDetection: &types.ExpenseDetection{
+				Text:       aws.String("$12.50"),
+				Confidence: aws.Float32(90),
+				Geometry: &types.Geometry{
+					BoundingBox: &types.BoundingBox{Left: 0.1, Top: 0.2, Width: 0.3, Height: 0.4},
+					Polygon:     []types.Point{{X: 0.1, Y: 0.2}},
+				},
+			},
+			Currency: &types.ExpenseCurrency{
+				Code:       aws.String("EUR"),
+				Confidence: aws.Float32(80),
+			},
+			GroupProperties: []types.ExpenseGroupProperty{
+				{Id: aws.String("g1"), Types: []string{"VENDOR", "ADDRESS"}},
+			},
+			PageNumber: aws.Int32(2),
+		}
+
+		field := newExpenseField(rawField)
+
+		assert.Equal(t, ExpenseFieldTypeTotal, field.FieldType())
+		assert.InDelta(t, 99, field.TypeConfidence(), 0.0001)
+		assert.Equal(t, "Total", field.Label().Text())
+		assert.Equal(t, "$12.50", field.Value().Text())
+		assert.InDelta(t, 90, field.Value().Confidence(), 0.0001)
+		assert.InDelta(t, 0.3, field.Value().BoundingBox().Width(), 0.0001)
+		assert.Len(t, field.Value().Polygon(), 1)
+		assert.Equal(t, "EUR", field.Currency().Code())
+		assert.Equal(t, "g1", field.GroupProperties()[0].ID())
+		assert.True(t, field.HasGroupType("ADDRESS"))
+		assert.False(t, field.HasGroupType("RECEIVER"))
+		assert.Equal(t, 2, field.PageNumber())
+		assert.Equal(t, "TOTAL: $12.50", field.String())
+	})
+
+	t.Run("MissingDetections", func(t *testing.T) {
+		field := newExpenseField(types.ExpenseField{})
+
+		assert.Equal(t, ExpenseFieldTypeOther, field.FieldType())
+		assert.Nil(t, field.Label())
+		assert.Nil(t, field.Value())
+		assert.Nil(t, field.Currency())
+		assert.Empty(t, field.GroupProperties())
+	})
+}
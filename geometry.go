@@ -1,9 +1,13 @@
 package textractor
 
 import (
+	"cmp"
 	"fmt"
 	"math"
+	"slices"
 	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/textract/types"
 )
 
 type BoundingBox struct {
@@ -13,6 +17,30 @@ type BoundingBox struct {
 	width  float64
 }
 
+// NewBoundingBox creates a new BoundingBox instance from normalized page coordinates.
+func NewBoundingBox(left, top, width, height float64) *BoundingBox {
+	return &BoundingBox{
+		height: height,
+		left:   left,
+		top:    top,
+		width:  width,
+	}
+}
+
+// newBoundingBox creates a new BoundingBox instance from the provided Textract bounding box.
+func newBoundingBox(bb *types.BoundingBox) *BoundingBox {
+	if bb == nil {
+		return nil
+	}
+
+	return &BoundingBox{
+		height: float64(bb.Height),
+		left:   float64(bb.Left),
+		top:    float64(bb.Top),
+		width:  float64(bb.Width),
+	}
+}
+
 // Bottom returns the bottom coordinate of the bounding box.
 func (bb *BoundingBox) Bottom() float64 {
 	return bb.Top() + bb.Height()
@@ -81,11 +109,29 @@ func (bb *BoundingBox) Intersection(other *BoundingBox) *BoundingBox {
 	return nil
 }
 
+// Distance returns the shortest distance between the edges of two bounding boxes, or zero if they overlap.
+func (bb *BoundingBox) Distance(other *BoundingBox) float64 {
+	dx := math.Max(0, math.Max(other.Left()-bb.Right(), bb.Left()-other.Right()))
+	dy := math.Max(0, math.Max(other.Top()-bb.Bottom(), bb.Top()-other.Bottom()))
+
+	return math.Hypot(dx, dy)
+}
+
 // String returns a string representation of the bounding box.
 func (bb *BoundingBox) String() string {
 	return fmt.Sprintf("[width: %f, height: %f, left: %f, top: %f]", bb.Width(), bb.Height(), bb.Left(), bb.Top())
 }
 
+// Polygon returns the outline of the bounding box, starting at the top-left corner.
+func (bb *BoundingBox) Polygon() Polygon {
+	return Polygon{
+		{x: bb.Left(), y: bb.Top()},
+		{x: bb.Right(), y: bb.Top()},
+		{x: bb.Right(), y: bb.Bottom()},
+		{x: bb.Left(), y: bb.Bottom()},
+	}
+}
+
 type BoundingBoxAccessor interface {
 	BoundingBox() *BoundingBox
 }
@@ -122,8 +168,23 @@ func NewEnclosingBoundingBox[T BoundingBoxAccessor](accessors ...T) *BoundingBox
 	}
 }
 
+// Polygon represents the outline of an element as a sequence of points. Textract polygons start at the
+// top-left corner of the element and are ordered clockwise on the page.
 type Polygon []*Point
 
+// newPolygon creates a new Polygon instance from the provided Textract points.
+func newPolygon(points []types.Point) Polygon {
+	polygon := make(Polygon, len(points))
+	for i, p := range points {
+		polygon[i] = &Point{
+			x: float64(p.X),
+			y: float64(p.Y),
+		}
+	}
+
+	return polygon
+}
+
 func (p Polygon) String() string {
 	points := make([]string, len(p))
 	for i, point := range p {
@@ -133,6 +194,253 @@ func (p Polygon) String() string {
 	return fmt.Sprintf("[%s]", strings.Join(points, ", "))
 }
 
+// BoundingBox returns the smallest axis-aligned bounding box enclosing the polygon, or nil if the polygon has no points.
+func (p Polygon) BoundingBox() *BoundingBox {
+	if len(p) == 0 {
+		return nil
+	}
+
+	left, top, right, bottom := math.Inf(1), math.Inf(1), math.Inf(-1), math.Inf(-1)
+
+	for _, point := range p {
+		left = math.Min(left, point.X())
+		top = math.Min(top, point.Y())
+		right = math.Max(right, point.X())
+		bottom = math.Max(bottom, point.Y())
+	}
+
+	return &BoundingBox{
+		height: bottom - top,
+		left:   left,
+		top:    top,
+		width:  right - left,
+	}
+}
+
+// Area calculates and returns the area of the polygon using the shoelace formula.
+// Polygons with less than three points have no area.
+func (p Polygon) Area() float64 {
+	return math.Abs(p.signedArea())
+}
+
+// signedArea returns the area of the polygon, which is positive if the points are ordered clockwise on the page.
+func (p Polygon) signedArea() float64 {
+	if len(p) < 3 {
+		return 0
+	}
+
+	var area float64
+
+	for i, point := range p {
+		next := p[(i+1)%len(p)]
+		area += point.X()*next.Y() - next.X()*point.Y()
+	}
+
+	return area / 2
+}
+
+// ContainsPoint checks if the point lies inside the polygon or on its outline.
+func (p Polygon) ContainsPoint(point *Point) bool {
+	if len(p) < 3 {
+		return false
+	}
+
+	inside := false
+
+	for i, j := 0, len(p)-1; i < len(p); j, i = i, i+1 {
+		a, b := p[i], p[j]
+
+		// Points on an edge are contained
+		if math.Abs(cross(a, b, point)) <= spatialEpsilon*math.Hypot(b.X()-a.X(), b.Y()-a.Y()) &&
+			point.X() >= math.Min(a.X(), b.X())-spatialEpsilon && point.X() <= math.Max(a.X(), b.X())+spatialEpsilon &&
+			point.Y() >= math.Min(a.Y(), b.Y())-spatialEpsilon && point.Y() <= math.Max(a.Y(), b.Y())+spatialEpsilon {
+			return true
+		}
+
+		if (a.Y() > point.Y()) != (b.Y() > point.Y()) &&
+			point.X() < (b.X()-a.X())*(point.Y()-a.Y())/(b.Y()-a.Y())+a.X() {
+			inside = !inside
+		}
+	}
+
+	return inside
+}
+
+// Contains checks if all points of the other polygon lie inside the polygon. The check is exact for
+// convex polygons, like the outlines of Textract elements.
+func (p Polygon) Contains(other Polygon) bool {
+	if len(other) == 0 {
+		return false
+	}
+
+	for _, point := range other {
+		if !p.ContainsPoint(point) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Intersection returns the polygon of the area covered by both polygons, or nil if they do not overlap.
+// The other polygon is clipped by the convex hull of the polygon, which is exact for convex polygons,
+// like the outlines of Textract elements.
+func (p Polygon) Intersection(other Polygon) Polygon {
+	clip := p.ConvexHull()
+	if len(clip) < 3 || len(other) < 3 {
+		return nil
+	}
+
+	result := slices.Clone(other)
+
+	// Sutherland-Hodgman clipping with each edge of the clockwise hull
+	for i, a := range clip {
+		b := clip[(i+1)%len(clip)]
+
+		input := result
+		result = make(Polygon, 0, len(input)+1)
+
+		for j, current := range input {
+			prev := input[(j+len(input)-1)%len(input)]
+
+			currentInside, prevInside := cross(a, b, current) >= 0, cross(a, b, prev) >= 0
+
+			if currentInside != prevInside {
+				result = append(result, lineIntersection(a, b, prev, current))
+			}
+
+			if currentInside {
+				result = append(result, current)
+			}
+		}
+
+		if len(result) == 0 {
+			return nil
+		}
+	}
+
+	if result.Area() <= spatialEpsilon*spatialEpsilon {
+		return nil
+	}
+
+	return result
+}
+
+// IoU returns the intersection over union of the two polygons, between 0 for disjoint and 1 for congruent polygons.
+func (p Polygon) IoU(other Polygon) float64 {
+	intersection := p.Intersection(other).Area()
+
+	union := p.Area() + other.Area() - intersection
+	if union <= 0 {
+		return 0
+	}
+
+	return intersection / union
+}
+
+// ConvexHull returns the smallest convex polygon enclosing all points of the polygon. The points of
+// the hull are ordered clockwise on the page, starting with the point closest to the top-left corner,
+// like the polygons of Textract, so that the first edge of the hull of a text element is its top edge.
+func (p Polygon) ConvexHull() Polygon {
+	points := make(Polygon, 0, len(p))
+
+	for _, point := range p {
+		if point != nil {
+			points = append(points, point)
+		}
+	}
+
+	slices.SortFunc(points, func(a, b *Point) int {
+		if c := cmp.Compare(a.X(), b.X()); c != 0 {
+			return c
+		}
+
+		return cmp.Compare(a.Y(), b.Y())
+	})
+
+	points = slices.CompactFunc(points, func(a, b *Point) bool {
+		return a.X() == b.X() && a.Y() == b.Y()
+	})
+
+	if len(points) < 3 {
+		return points
+	}
+
+	// Andrew's monotone chain, the lower chain in page coordinates is the top of the hull
+	hull := make(Polygon, 0, 2*len(points))
+
+	for _, point := range points {
+		for len(hull) >= 2 && cross(hull[len(hull)-2], hull[len(hull)-1], point) <= 0 {
+			hull = hull[:len(hull)-1]
+		}
+
+		hull = append(hull, point)
+	}
+
+	for i, lower := len(points)-2, len(hull)+1; i >= 0; i-- {
+		for len(hull) >= lower && cross(hull[len(hull)-2], hull[len(hull)-1], points[i]) <= 0 {
+			hull = hull[:len(hull)-1]
+		}
+
+		hull = append(hull, points[i])
+	}
+
+	hull = hull[:len(hull)-1]
+
+	start := 0
+
+	for i, point := range hull {
+		if point.X()+point.Y() < hull[start].X()+hull[start].Y() {
+			start = i
+		}
+	}
+
+	return append(hull[start:], hull[:start]...)
+}
+
+// cross returns the cross product of the vectors a->b and a->c. It is positive if c lies
+// right of the edge a->b on the page, i.e. inside of a clockwise polygon.
+func cross(a, b, c *Point) float64 {
+	return (b.X()-a.X())*(c.Y()-a.Y()) - (b.Y()-a.Y())*(c.X()-a.X())
+}
+
+// lineIntersection returns the intersection point of the line through a and b with the segment from c to d.
+func lineIntersection(a, b, c, d *Point) *Point {
+	t := cross(a, b, c) / (cross(a, b, c) - cross(a, b, d))
+
+	return &Point{
+		x: c.X() + t*(d.X()-c.X()),
+		y: c.Y() + t*(d.Y()-c.Y()),
+	}
+}
+
+type PolygonAccessor interface {
+	Polygon() Polygon
+}
+
+// NewEnclosingPolygon returns the convex hull of the polygons of multiple elements, or nil if none of them has a polygon.
+func NewEnclosingPolygon[T PolygonAccessor](accessors ...T) Polygon {
+	var points Polygon
+
+	for _, a := range accessors {
+		points = append(points, a.Polygon()...)
+	}
+
+	if len(points) == 0 {
+		return nil
+	}
+
+	return points.ConvexHull()
+}
+
+// NewPoint creates a new Point instance from normalized page coordinates.
+func NewPoint(x, y float64) *Point {
+	return &Point{
+		x: x,
+		y: y,
+	}
+}
+
 // Point represents a 2D point.
 type Point struct {
 	x, y float64
@@ -168,3 +476,36 @@ func (o *Orientation) Radians() float64 {
 func (o *Orientation) Degrees() float64 {
 	return (o.Radians() * 180) / math.Pi
 }
+
+// Rotation returns the orientation rounded to a multiple of 90 degrees, i.e. 0, 90, 180 or 270.
+// Angles increase clockwise, as the y-axis of page coordinates points down.
+func (o *Orientation) Rotation() int {
+	r := int(math.Round(o.Degrees()/90)) * 90
+
+	return (r + 360) % 360
+}
+
+// Skew returns the deviation of the orientation from its rotation in degrees, between -45 and 45.
+func (o *Orientation) Skew() float64 {
+	skew := math.Mod(o.Degrees()-float64(o.Rotation()), 360)
+
+	switch {
+	case skew > 180:
+		skew -= 360
+	case skew < -180:
+		skew += 360
+	}
+
+	return skew
+}
+
+// Orientation returns the orientation of the first edge of the polygon. Textract polygons start at the
+// top-left corner of the text, so the first edge follows the text baseline. It returns nil if the polygon
+// has less than two points.
+func (p Polygon) Orientation() *Orientation {
+	if len(p) < 2 {
+		return nil
+	}
+
+	return &Orientation{point0: p[0], point1: p[1]}
+}
@@ -1,6 +1,7 @@
 package textractor
 
 import (
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -24,6 +25,15 @@ func TestBoundingBox(t *testing.T) {
 		assert.Equal(t, float64(0), bb5.Area(), "Area should be 0 for a bounding box with negative height")
 	})
 
+	t.Run("Distance", func(t *testing.T) {
+		bb := &BoundingBox{left: 0, top: 0, width: 5, height: 5}
+
+		assert.Equal(t, 0.0, bb.Distance(&BoundingBox{left: 3, top: 3, width: 5, height: 5}))
+		assert.Equal(t, 2.0, bb.Distance(&BoundingBox{left: 7, top: 1, width: 5, height: 2}))
+		assert.Equal(t, 2.0, bb.Distance(&BoundingBox{left: 1, top: -4, width: 2, height: 2}))
+		assert.Equal(t, 5.0, bb.Distance(&BoundingBox{left: 8, top: 9, width: 1, height: 1}))
+	})
+
 	t.Run("Intersection", func(t *testing.T) {
 		t.Run("Bounding boxes do not intersect", func(t *testing.T) {
 			bb1 := &BoundingBox{left: 0, top: 0, width: 5, height: 5}
@@ -94,14 +104,6 @@ func TestNewEnclosingBoundingBox(t *testing.T) {
 	assert.InDelta(t, expectedResult5.Height(), result5.Height(), 0.0001, "Floating-point coordinates not within tolerance")
 }
 
-type mockBoundingBoxAccessor struct {
-	bbox *BoundingBox
-}
-
-func (ba *mockBoundingBoxAccessor) BoundingBox() *BoundingBox {
-	return ba.bbox
-}
-
 func TestOrientation(t *testing.T) {
 	t.Run("Radians", func(t *testing.T) {
 		point0 := &Point{x: 0, y: 0}
@@ -116,4 +118,129 @@ func TestOrientation(t *testing.T) {
 		orientation := &Orientation{point0, point1}
 		assert.InDelta(t, 45.0, orientation.Degrees(), 0.001, "Degrees should be approximately 45.0")
 	})
+
+	t.Run("Rotation and skew", func(t *testing.T) {
+		tests := []struct {
+			x, y     float64
+			rotation int
+			skew     float64
+		}{
+			{1, 0, 0, 0},
+			{0, 1, 90, 0},
+			{-1, 0, 180, 0},
+			{0, -1, 270, 0},
+			{math.Cos(0.1), math.Sin(0.1), 0, 0.1 * 180 / math.Pi},
+			{math.Cos(-0.1), math.Sin(-0.1), 0, -0.1 * 180 / math.Pi},
+			{-math.Cos(0.1), -math.Sin(0.1), 180, 0.1 * 180 / math.Pi},
+		}
+
+		for _, tt := range tests {
+			o := &Orientation{&Point{x: 0, y: 0}, &Point{x: tt.x, y: tt.y}}
+			assert.Equal(t, tt.rotation, o.Rotation())
+			assert.InDelta(t, tt.skew, o.Skew(), 1e-9)
+		}
+	})
+
+	t.Run("Polygon", func(t *testing.T) {
+		assert.Nil(t, Polygon{}.Orientation())
+
+		w := &Word{base: base{polygon: Polygon{{x: 0.1, y: 0.1}, {x: 0.1, y: 0.2}, {x: 0.05, y: 0.2}, {x: 0.05, y: 0.1}}}}
+		assert.Equal(t, 90, w.Orientation().Rotation())
+	})
+
+	t.Run("KeyValue", func(t *testing.T) {
+		assert.Nil(t, (&KeyValue{}).Orientation())
+
+		// A key-value pair rotated by 90 degrees, with the value below the key
+		kv := &KeyValue{
+			key:   &Key{base: base{polygon: Polygon{{x: 0.1, y: 0.1}, {x: 0.1, y: 0.2}, {x: 0.05, y: 0.2}, {x: 0.05, y: 0.1}}}},
+			value: &Value{base: base{polygon: Polygon{{x: 0.1, y: 0.25}, {x: 0.1, y: 0.4}, {x: 0.05, y: 0.4}, {x: 0.05, y: 0.25}}}},
+		}
+		assert.Equal(t, 90, kv.Orientation().Rotation())
+
+		kv = &KeyValue{value: &Value{base: base{boundingBox: &BoundingBox{left: 0.1, top: 0.1, width: 0.2, height: 0.05}}}}
+		assert.Equal(t, 0, kv.Orientation().Rotation(), "Orientation should fall back to the enclosing polygon")
+	})
+}
+
+func TestPolygon(t *testing.T) {
+	square := Polygon{{x: 0, y: 0}, {x: 2, y: 0}, {x: 2, y: 2}, {x: 0, y: 2}}
+	diamond := Polygon{{x: 1, y: 0}, {x: 2, y: 1}, {x: 1, y: 2}, {x: 0, y: 1}}
+	shifted := Polygon{{x: 1, y: 1}, {x: 3, y: 1}, {x: 3, y: 3}, {x: 1, y: 3}}
+	distant := Polygon{{x: 5, y: 5}, {x: 6, y: 5}, {x: 6, y: 6}, {x: 5, y: 6}}
+
+	t.Run("Area", func(t *testing.T) {
+		assert.Equal(t, 4.0, square.Area())
+		assert.Equal(t, 2.0, diamond.Area())
+		assert.Equal(t, 4.0, Polygon{{x: 0, y: 2}, {x: 2, y: 2}, {x: 2, y: 0}, {x: 0, y: 0}}.Area(), "Area should not depend on the point order")
+		assert.Equal(t, 0.0, Polygon{{x: 0, y: 0}, {x: 1, y: 1}}.Area())
+		assert.Equal(t, 0.0, Polygon(nil).Area())
+	})
+
+	t.Run("BoundingBox", func(t *testing.T) {
+		assert.Equal(t, &BoundingBox{left: 0, top: 0, width: 2, height: 2}, diamond.BoundingBox())
+		assert.Nil(t, Polygon(nil).BoundingBox())
+	})
+
+	t.Run("ContainsPoint", func(t *testing.T) {
+		assert.True(t, diamond.ContainsPoint(&Point{x: 1, y: 1}))
+		assert.True(t, diamond.ContainsPoint(&Point{x: 1.5, y: 0.5}), "Points on the outline should be contained")
+		assert.False(t, diamond.ContainsPoint(&Point{x: 0.2, y: 0.2}), "Corners of the bounding box should not be contained")
+	})
+
+	t.Run("Contains", func(t *testing.T) {
+		assert.True(t, square.Contains(diamond))
+		assert.False(t, diamond.Contains(square))
+		assert.False(t, square.Contains(shifted))
+		assert.False(t, square.Contains(nil))
+	})
+
+	t.Run("Intersection", func(t *testing.T) {
+		assert.InDelta(t, 1.0, square.Intersection(shifted).Area(), 1e-9)
+		assert.Equal(t, &BoundingBox{left: 1, top: 1, width: 1, height: 1}, square.Intersection(shifted).BoundingBox())
+		assert.InDelta(t, 2.0, square.Intersection(diamond).Area(), 1e-9)
+		assert.InDelta(t, 0.5, diamond.Intersection(shifted).Area(), 1e-9)
+		assert.Nil(t, square.Intersection(distant))
+		assert.Nil(t, square.Intersection(Polygon{{x: 2, y: 0}, {x: 3, y: 0}, {x: 3, y: 2}, {x: 2, y: 2}}), "Polygons sharing an edge should not intersect")
+	})
+
+	t.Run("IoU", func(t *testing.T) {
+		assert.InDelta(t, 1.0, square.IoU(square), 1e-9)
+		assert.InDelta(t, 1.0/7, square.IoU(shifted), 1e-9)
+		assert.InDelta(t, 0.5, square.IoU(diamond), 1e-9)
+		assert.Equal(t, 0.0, square.IoU(distant))
+		assert.Equal(t, 0.0, Polygon(nil).IoU(nil))
+	})
+
+	t.Run("ConvexHull", func(t *testing.T) {
+		points := Polygon{{x: 1, y: 1}, {x: 2, y: 2}, {x: 0, y: 2}, {x: 2, y: 0}, {x: 0, y: 0}, {x: 1, y: 0}, {x: 0, y: 0}}
+		assert.Equal(t, square, points.ConvexHull(), "Hull should start at the top-left corner and skip inner, collinear and duplicate points")
+
+		skewed := Polygon{{x: 0.1, y: 0.2}, {x: 0.5, y: 0.22}, {x: 0.499, y: 0.26}, {x: 0.099, y: 0.24}}
+		assert.Equal(t, skewed, skewed.ConvexHull())
+		assert.InDelta(t, skewed.Orientation().Degrees(), skewed.ConvexHull().Orientation().Degrees(), 1e-9)
+	})
+
+	t.Run("NewEnclosingPolygon", func(t *testing.T) {
+		polygon := NewEnclosingPolygon(&mockPolygonAccessor{polygon: square}, &mockPolygonAccessor{}, &mockPolygonAccessor{polygon: shifted})
+		assert.Equal(t, Polygon{{x: 0, y: 0}, {x: 2, y: 0}, {x: 3, y: 1}, {x: 3, y: 3}, {x: 1, y: 3}, {x: 0, y: 2}}, polygon)
+		assert.Equal(t, 8.0, polygon.Area())
+		assert.Nil(t, NewEnclosingPolygon(&mockPolygonAccessor{}))
+	})
+}
+
+type mockPolygonAccessor struct {
+	polygon Polygon
+}
+
+func (pa *mockPolygonAccessor) Polygon() Polygon {
+	return pa.polygon
+}
+
+type mockBoundingBoxAccessor struct {
+	bbox *BoundingBox
+}
+
+func (ba *mockBoundingBoxAccessor) BoundingBox() *BoundingBox {
+	return ba.bbox
 }
@@ -0,0 +1,325 @@
+package textractor
+
+import (
+	"fmt"
+	"html"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/textract/types"
+)
+
+// HTML exports the document as semantic HTML. Every page is rendered as a <section> and every
+// element carries its block ID, confidence and bounding box as data-* attributes.
+func (d *Document) HTML(optFns ...func(*TextLinearizationOptions)) string {
+	sb := &strings.Builder{}
+
+	sb.WriteString("<article>\n")
+
+	for _, p := range d.Pages() {
+		sb.WriteString(p.HTML(optFns...))
+	}
+
+	sb.WriteString("</article>\n")
+
+	return sb.String()
+}
+
+// HTML exports the page as a semantic HTML <section>.
+func (p *Page) HTML(optFns ...func(*TextLinearizationOptions)) string {
+	opts := DefaultLinerizationOptions
+
+	for _, fn := range optFns {
+		fn(&opts)
+	}
+
+	sb := &strings.Builder{}
+
+	fmt.Fprintf(sb, "<section data-id=\"%s\" data-page=\"%d\">\n", html.EscapeString(p.id), p.Number())
+
+	for _, l := range p.sortedLayouts(opts.ReadingOrder) {
+		l.writeHTML(sb, opts)
+	}
+
+	sb.WriteString("</section>\n")
+
+	return sb.String()
+}
+
+// writeHTML writes the layout as the HTML element matching its block type.
+func (l *Layout) writeHTML(sb *strings.Builder, opts TextLinearizationOptions) {
+	switch l.BlockType() { // nolint exhaustive
+	case types.BlockTypeLayoutHeader:
+		if !opts.HideHeaderLayout {
+			writeHTMLTextElement(sb, "header", &l.base, l.htmlText(opts))
+		}
+	case types.BlockTypeLayoutFooter:
+		if !opts.HideFooterLayout {
+			writeHTMLTextElement(sb, "footer", &l.base, l.htmlText(opts))
+		}
+	case types.BlockTypeLayoutFigure:
+		if !opts.HideFigureLayout {
+			writeHTMLTextElement(sb, "figure", &l.base, l.htmlText(opts))
+		}
+	case types.BlockTypeLayoutPageNumber:
+		if !opts.HidePageNumberLayout {
+			writeHTMLTextElement(sb, "p", &l.base, l.htmlText(opts))
+		}
+	case types.BlockTypeLayoutTitle:
+		writeHTMLTextElement(sb, "h1", &l.base, l.htmlText(opts))
+	case types.BlockTypeLayoutSectionHeader:
+		writeHTMLTextElement(sb, "h2", &l.base, l.htmlText(opts))
+	case types.BlockTypeLayoutList:
+		fmt.Fprintf(sb, "<ul%s>\n", htmlDataAttributes(&l.base))
+
+		for _, c := range l.children {
+			if item, ok := c.(*Layout); ok {
+				writeHTMLTextElement(sb, "li", &item.base, item.htmlText(opts))
+			} else {
+				writeHTMLTextElement(sb, "li", nil, c.Text(func(tlo *TextLinearizationOptions) {
+					*tlo = opts
+				}))
+			}
+		}
+
+		sb.WriteString("</ul>\n")
+	case types.BlockTypeLayoutTable, types.BlockTypeLayoutKeyValue:
+		l.writeHTMLContainer(sb, opts)
+	default:
+		writeHTMLTextElement(sb, "p", &l.base, l.htmlText(opts))
+	}
+}
+
+// writeHTMLContainer writes tables and key-value pairs of the layout as <table> and <dl> elements,
+// and any remaining children as paragraphs.
+func (l *Layout) writeHTMLContainer(sb *strings.Builder, opts TextLinearizationOptions) {
+	fmt.Fprintf(sb, "<div%s>\n", htmlDataAttributes(&l.base))
+
+	var (
+		inList bool
+		others []LayoutChild
+	)
+
+	flushOthers := func() {
+		if len(others) > 0 {
+			text := l.linearizeChildren(others, opts)
+			writeHTMLTextElement(sb, "p", nil, text)
+
+			others = nil
+		}
+	}
+
+	for _, group := range groupElementsHorizontally(l.children, opts.HeuristicOverlapRatio) {
+		sort.Slice(group, func(i, j int) bool {
+			return group[i].BoundingBox().Left() < group[j].BoundingBox().Left()
+		})
+
+		for _, child := range group {
+			if kv, ok := child.(*KeyValue); ok {
+				flushOthers()
+
+				if !inList {
+					sb.WriteString("<dl>\n")
+
+					inList = true
+				}
+
+				kv.writeHTML(sb, opts)
+
+				continue
+			}
+
+			if inList {
+				sb.WriteString("</dl>\n")
+
+				inList = false
+			}
+
+			switch c := child.(type) {
+			case *Table:
+				flushOthers()
+				c.writeHTML(sb, opts)
+			case *Signature:
+				flushOthers()
+				writeHTMLTextElement(sb, "p", &c.base, c.Text(func(tlo *TextLinearizationOptions) {
+					*tlo = opts
+				}))
+			default:
+				others = append(others, child)
+			}
+		}
+	}
+
+	if inList {
+		sb.WriteString("</dl>\n")
+	}
+
+	flushOthers()
+
+	sb.WriteString("</div>\n")
+}
+
+// htmlText returns the text of the layout without the prefixes and suffixes of the linearization options.
+func (l *Layout) htmlText(opts TextLinearizationOptions) string {
+	return strings.TrimSpace(l.linearizeChildren(l.children, opts))
+}
+
+// writeHTML writes the key-value pair as a term and description of a definition list.
+func (kv *KeyValue) writeHTML(sb *strings.Builder, opts TextLinearizationOptions) {
+	if kv.Key() != nil {
+		writeHTMLTextElement(sb, "dt", &kv.Key().base, kv.Key().Text())
+	}
+
+	if kv.Value() == nil {
+		return
+	}
+
+	if se := kv.Value().SelectionElement(); se != nil {
+		checked := ""
+		if se.IsSelected() {
+			checked = " checked"
+		}
+
+		fmt.Fprintf(sb, "<dd%s><input type=\"checkbox\" disabled%s%s></dd>\n", htmlDataAttributes(&kv.Value().base), checked, htmlDataAttributes(&se.base))
+
+		return
+	}
+
+	writeHTMLTextElement(sb, "dd", &kv.Value().base, kv.Value().Text(func(tlo *TextLinearizationOptions) {
+		*tlo = opts
+	}))
+}
+
+// writeHTML writes the table as a <table> element, using rowspan and colspan for merged cells.
+func (t *Table) writeHTML(sb *strings.Builder, opts TextLinearizationOptions) {
+	fmt.Fprintf(sb, "<table%s>\n", htmlDataAttributes(&t.base))
+
+	if t.title != nil {
+		writeHTMLTextElement(sb, "caption", &t.title.base, t.title.Text())
+	}
+
+	type position struct{ row, column int }
+
+	cellMap := make(map[position]*TableCell, len(t.cells))
+	for _, c := range t.cells {
+		cellMap[position{c.rowIndex, c.columnIndex}] = c
+	}
+
+	mergedCellMap := make(map[position]*TableMergedCell)
+
+	for _, mc := range t.mergedCells {
+		for r := mc.rowIndex; r < mc.rowIndex+mc.rowSpan; r++ {
+			for c := mc.columnIndex; c < mc.columnIndex+mc.columnSpan; c++ {
+				mergedCellMap[position{r, c}] = mc
+			}
+		}
+	}
+
+	columnCount := t.ColumnCount()
+	section := ""
+
+	for _, row := range t.Rows() {
+		r := row.Index()
+
+		// Group the header rows in <thead> and the remaining rows in <tbody>
+		next := "tbody"
+		if row.IsColumnHeader() {
+			next = "thead"
+		}
+
+		if next != section {
+			if section != "" {
+				fmt.Fprintf(sb, "</%s>\n", section)
+			}
+
+			fmt.Fprintf(sb, "<%s>\n", next)
+
+			section = next
+		}
+
+		sb.WriteString("<tr>\n")
+
+		for c := 1; c <= columnCount; c++ {
+			if mc, ok := mergedCellMap[position{r, c}]; ok {
+				if mc.rowIndex == r && mc.columnIndex == c {
+					writeHTMLCell(sb, &mc.cell, mc.Text())
+				}
+
+				continue
+			}
+
+			if tc, ok := cellMap[position{r, c}]; ok {
+				writeHTMLCell(sb, &tc.cell, tc.Text(func(tlo *TextLinearizationOptions) {
+					*tlo = opts
+				}))
+			}
+		}
+
+		sb.WriteString("</tr>\n")
+	}
+
+	if section != "" {
+		fmt.Fprintf(sb, "</%s>\n", section)
+	}
+
+	if len(t.footers) > 0 {
+		sb.WriteString("<tfoot>\n")
+
+		for _, f := range t.footers {
+			fmt.Fprintf(sb, "<tr><td colspan=\"%d\"%s>%s</td></tr>\n", columnCount, htmlDataAttributes(&f.base), html.EscapeString(f.Text()))
+		}
+
+		sb.WriteString("</tfoot>\n")
+	}
+
+	sb.WriteString("</table>\n")
+}
+
+// writeHTMLCell writes a table cell as <th> for column headers and <td> otherwise.
+func writeHTMLCell(sb *strings.Builder, c *cell, text string) {
+	tag := "td"
+	if c.IsColumnHeader() {
+		tag = "th"
+	}
+
+	spans := ""
+	if c.rowSpan > 1 {
+		spans += fmt.Sprintf(" rowspan=\"%d\"", c.rowSpan)
+	}
+
+	if c.columnSpan > 1 {
+		spans += fmt.Sprintf(" colspan=\"%d\"", c.columnSpan)
+	}
+
+	fmt.Fprintf(sb, "<%s%s%s>%s</%s>\n", tag, spans, htmlDataAttributes(&c.base), html.EscapeString(text), tag)
+}
+
+// writeHTMLTextElement writes an element with escaped text content.
+func writeHTMLTextElement(sb *strings.Builder, tag string, b *base, text string) {
+	fmt.Fprintf(sb, "<%s%s>%s</%s>\n", tag, htmlDataAttributes(b), html.EscapeString(text), tag)
+}
+
+// htmlDataAttributes returns the data-* attributes describing the block ID, confidence and bounding box.
+func htmlDataAttributes(b *base) string {
+	if b == nil {
+		return ""
+	}
+
+	attrs := &strings.Builder{}
+
+	if b.id != "" {
+		fmt.Fprintf(attrs, " data-id=\"%s\"", html.EscapeString(b.id))
+	}
+
+	if b.blockType != "" {
+		fmt.Fprintf(attrs, " data-block-type=\"%s\"", html.EscapeString(string(b.blockType)))
+	}
+
+	fmt.Fprintf(attrs, " data-confidence=\"%g\"", b.confidence)
+
+	if bb := b.boundingBox; bb != nil {
+		fmt.Fprintf(attrs, " data-left=\"%g\" data-top=\"%g\" data-width=\"%g\" data-height=\"%g\"", bb.Left(), bb.Top(), bb.Width(), bb.Height())
+	}
+
+	return attrs.String()
+}
@@ -0,0 +1,84 @@
+package textractor
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/textract/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHTML(t *testing.T) {
+	t.Run("SimpleTableLayout", func(t *testing.T) {
+		res, err := loadDocumentAPIOutputTestdata("testdata/test-simple-table-layout.json")
+		assert.NoError(t, err)
+
+		doc, err := ParseDocumentAPIOutput(res)
+		assert.NoError(t, err)
+
+		h := doc.HTML()
+
+		assert.Contains(t, h, "<article>\n<section data-id=\"239aed8e-4077-44ef-9426-1d6d5b89c86f\"")
+		assert.Contains(t, h, `<h1 data-id="72bf7801-979c-48d5-934d-80c0c4506c6f" data-block-type="LAYOUT_TITLE" data-confidence="76.953125" data-left="0.0973353385925293" data-top="0.0760771706700325" data-width="0.36820873618125916" data-height="0.026949726045131683">New Document</h1>`)
+		assert.Contains(t, h, ">Paragraph 1</h2>")
+		assert.Contains(t, h, "<table data-id=\"1a7eb750-62ab-494f-89cb-8d55e2151e4c\"")
+		assert.Contains(t, h, ">Paragraph 1</caption>")
+		assert.Contains(t, h, ">A1</td>")
+		assert.Contains(t, h, "</section>\n</article>\n")
+	})
+
+	t.Run("MergedCells", func(t *testing.T) {
+		res, err := loadDocumentAPIOutputTestdata("testdata/test-document.json")
+		assert.NoError(t, err)
+
+		doc, err := ParseDocumentAPIOutput(res)
+		assert.NoError(t, err)
+
+		h := doc.Tables()[0].page.HTML()
+
+		assert.Contains(t, h, `<thead>
+<tr>
+<th data-id="d45909bc-628c-4591-b45d-607b35f6dbdb"`)
+		assert.Contains(t, h, `<th colspan="2" data-id="301cf79a-b754-4c71-a8d0-ab06e197c99c" data-block-type="MERGED_CELL"`)
+		assert.NotContains(t, h, ">Cell 3</th>")
+		assert.Contains(t, h, ">Cell 4</th>")
+		assert.Contains(t, h, "</tr>\n</thead>\n<tbody>\n<tr>\n<td data-id=\"e0bf3b91-106f-414a-b628-809093587687\"")
+	})
+
+	t.Run("KeyValuesAndSelectionElements", func(t *testing.T) {
+		res, err := loadDocumentAPIOutputTestdata("testdata/test-document.json")
+		assert.NoError(t, err)
+
+		doc, err := ParseDocumentAPIOutput(res)
+		assert.NoError(t, err)
+
+		h := doc.HTML()
+
+		assert.Contains(t, h, "<dl>\n<dt data-id=\"468e6822-671b-43ea-8f85-b6033d09003f\"")
+		assert.Contains(t, h, "<input type=\"checkbox\" disabled checked")
+		assert.Contains(t, h, "<input type=\"checkbox\" disabled data-id")
+	})
+
+	t.Run("ListAndEscaping", func(t *testing.T) {
+		page := &Page{id: "p1"}
+		line1 := &Line{base: base{id: "l1", boundingBox: &BoundingBox{top: 0.1, height: 0.01}}, words: []*Word{{text: "<b>&"}}}
+		line2 := &Line{base: base{id: "l2", boundingBox: &BoundingBox{top: 0.2, height: 0.01}}, words: []*Word{{text: "second"}}}
+
+		list := &Layout{
+			base: base{id: "list", blockType: types.BlockTypeLayoutList, boundingBox: &BoundingBox{top: 0.1, height: 0.11}},
+			children: []LayoutChild{
+				&Layout{base: base{id: "i1", blockType: types.BlockTypeLayoutText, boundingBox: line1.BoundingBox()}, children: []LayoutChild{line1}, noNewLines: true},
+				&Layout{base: base{id: "i2", blockType: types.BlockTypeLayoutText, boundingBox: line2.BoundingBox()}, children: []LayoutChild{line2}, noNewLines: true},
+			},
+		}
+
+		page.AddLayouts(list)
+
+		assert.Equal(t, `<section data-id="p1" data-page="0">
+<ul data-id="list" data-block-type="LAYOUT_LIST" data-confidence="0" data-left="0" data-top="0.1" data-width="0" data-height="0.11">
+<li data-id="i1" data-block-type="LAYOUT_TEXT" data-confidence="0" data-left="0" data-top="0.1" data-width="0" data-height="0.01">&lt;b&gt;&amp;</li>
+<li data-id="i2" data-block-type="LAYOUT_TEXT" data-confidence="0" data-left="0" data-top="0.2" data-width="0" data-height="0.01">second</li>
+</ul>
+</section>
+`, page.HTML())
+	})
+}
@@ -4,6 +4,16 @@ type IdentityDocument struct {
 	document  *Document
 	fields    []*IdentityDocumentField
 	fieldsMap map[IdentityDocumentFieldType]*IdentityDocumentField
+	report    *ParseReport
+}
+
+// ParseReport returns the problems found while parsing the response. It is shared by all documents of the response.
+func (id *IdentityDocument) ParseReport() *ParseReport {
+	if id.report == nil {
+		return &ParseReport{}
+	}
+
+	return id.report
 }
 
 func (id *IdentityDocument) Document() *Document {
@@ -0,0 +1,50 @@
+package internal
+
+import "strings"
+
+// diacriticsReplacer replaces the Latin letters with diacritics of the Latin-1 Supplement and
+// Latin Extended-A blocks with their base letters.
+var diacriticsReplacer = func() *strings.Replacer {
+	groups := []struct {
+		letters string
+		base    string
+	}{
+		{"ÀÁÂÃÄÅĀĂĄ", "A"}, {"àáâãäåāăą", "a"},
+		{"ÇĆĈĊČ", "C"}, {"çćĉċč", "c"},
+		{"ĎĐ", "D"}, {"ďđ", "d"},
+		{"ÈÉÊËĒĔĖĘĚ", "E"}, {"èéêëēĕėęě", "e"},
+		{"ĜĞĠĢ", "G"}, {"ĝğġģ", "g"},
+		{"ĤĦ", "H"}, {"ĥħ", "h"},
+		{"ÌÍÎÏĨĪĬĮİ", "I"}, {"ìíîïĩīĭįı", "i"},
+		{"Ĵ", "J"}, {"ĵ", "j"},
+		{"Ķ", "K"}, {"ķ", "k"},
+		{"ĹĻĽĿŁ", "L"}, {"ĺļľŀł", "l"},
+		{"ÑŃŅŇ", "N"}, {"ñńņň", "n"},
+		{"ÒÓÔÕÖØŌŎŐ", "O"}, {"òóôõöøōŏő", "o"},
+		{"ŔŖŘ", "R"}, {"ŕŗř", "r"},
+		{"ŚŜŞŠ", "S"}, {"śŝşš", "s"},
+		{"ŢŤŦ", "T"}, {"ţťŧ", "t"},
+		{"ÙÚÛÜŨŪŬŮŰŲ", "U"}, {"ùúûüũūŭůűų", "u"},
+		{"Ŵ", "W"}, {"ŵ", "w"},
+		{"ÝŶŸ", "Y"}, {"ýÿŷ", "y"},
+		{"ŹŻŽ", "Z"}, {"źżž", "z"},
+		{"Æ", "AE"}, {"æ", "ae"},
+		{"Œ", "OE"}, {"œ", "oe"},
+		{"ß", "ss"},
+	}
+
+	var oldnew []string
+
+	for _, g := range groups {
+		for _, r := range g.letters {
+			oldnew = append(oldnew, string(r), g.base)
+		}
+	}
+
+	return strings.NewReplacer(oldnew...)
+}()
+
+// RemoveDiacritics replaces Latin letters with diacritics by their base letters, e.g. "é" by "e" and "ß" by "ss".
+func RemoveDiacritics(s string) string {
+	return diacriticsReplacer.Replace(s)
+}
@@ -0,0 +1,27 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRemoveDiacritics(t *testing.T) {
+	assert := assert.New(t)
+
+	testCases := []struct {
+		input    string
+		expected string
+	}{
+		{"Date of Birth", "Date of Birth"},
+		{"Geburtsdatum / Straße", "Geburtsdatum / Strasse"},
+		{"Né à Besançon", "Ne a Besancon"},
+		{"Łódź, Kraków", "Lodz, Krakow"},
+		{"Ærøskøbing", "AEroskobing"},
+		{"日本語", "日本語"},
+	}
+
+	for _, testCase := range testCases {
+		assert.Equal(testCase.expected, RemoveDiacritics(testCase.input), "Error in test case: %s", testCase.input)
+	}
+}
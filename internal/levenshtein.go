@@ -2,29 +2,33 @@ package internal
 
 // ComputeLevenshteinDistance calculates the Levenshtein distance between two strings.
 // It measures the minimum number of single-character edits (insertions, deletions,
-// or substitutions) required to change one string into the other.
+// or substitutions) required to change one string into the other. Characters are
+// compared as runes.
 func ComputeLevenshteinDistance(s1, s2 string) int {
-	// If one of the strings is empty, return the length of the other string.
-	if len(s1) == 0 {
-		return len(s2)
+	// If the strings are equal, the distance is zero.
+	if s1 == s2 {
+		return 0
 	}
 
-	if len(s2) == 0 {
-		return len(s1)
+	// Compare runes instead of bytes, so that a non-ASCII character counts as a single edit.
+	r1, r2 := []rune(s1), []rune(s2)
+
+	// If one of the strings is empty, return the length of the other string.
+	if len(r1) == 0 {
+		return len(r2)
 	}
 
-	// If the strings are equal, the distance is zero.
-	if s1 == s2 {
-		return 0
+	if len(r2) == 0 {
+		return len(r1)
 	}
 
 	// Swap to save memory (O(min(a,b)) instead of O(a)).
-	if len(s1) > len(s2) {
-		s1, s2 = s2, s1
+	if len(r1) > len(r2) {
+		r1, r2 = r2, r1
 	}
 
-	lenS1 := len(s1)
-	lenS2 := len(s2)
+	lenS1 := len(r1)
+	lenS2 := len(r2)
 
 	x := make([]uint16, lenS1+1)
 	for i := 1; i <= lenS1; i++ {
@@ -36,7 +40,7 @@ func ComputeLevenshteinDistance(s1, s2 string) int {
 
 		for j := 1; j <= lenS1; j++ {
 			current := x[j-1]
-			if s2[i-1] != s1[j-1] {
+			if r2[i-1] != r1[j-1] {
 				current = minUint16(minUint16(x[j-1]+1, prev+1), x[j]+1)
 			}
 
@@ -25,6 +25,9 @@ func TestComputeLevenshteinDistance(t *testing.T) {
 		{"k", "k", 0},                 // Single-character strings
 		{"", "", 0},                   // Empty strings
 		{"abcd", "", 4},               // Empty string to a non-empty string
+		{"naïve", "naive", 1},         // Non-ASCII substitution
+		{"straße", "strasse", 2},      // Non-ASCII substitution and insertion
+		{"日本語", "日本", 1},              // Multi-byte characters
 	}
 
 	for _, testCase := range testCases {
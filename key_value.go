@@ -66,13 +66,71 @@ func (kv *KeyValue) OCRConfidence() *OCRConfidence {
 
 // BoundingBox returns the bounding box that encloses the key-value pair.
 func (kv *KeyValue) BoundingBox() *BoundingBox {
-	return NewEnclosingBoundingBox[BoundingBoxAccessor](kv.Key(), kv.Value())
+	return NewEnclosingBoundingBox(kv.parts()...)
 }
 
-// Polygon returns the polygon representing the key-value pair.
+// Polygon returns the polygon enclosing the key and the value, which is the convex hull of their polygons.
+// It falls back to the outline of the bounding box if neither the key nor the value has a polygon.
 func (kv *KeyValue) Polygon() Polygon {
-	// TODO
-	panic("not implemented")
+	if polygon := NewEnclosingPolygon(kv.parts()...); polygon != nil {
+		return polygon
+	}
+
+	if bb := kv.BoundingBox(); bb != nil {
+		return bb.Polygon()
+	}
+
+	return nil
+}
+
+// Orientation returns the orientation of the key-value pair derived from the polygons of the key and the
+// value, as the enclosing polygon always starts at its top-left point. It falls back to the enclosing
+// polygon, and returns nil if the key-value pair has no polygon.
+func (kv *KeyValue) Orientation() *Orientation {
+	polygons := make([]Polygon, 0, 2)
+	for _, part := range kv.parts() {
+		polygons = append(polygons, part.polygon)
+	}
+
+	if o := estimateOrientation(polygons); o != nil {
+		return o
+	}
+
+	return kv.Polygon().Orientation()
+}
+
+// BoundingBoxIn returns the bounding box of the key-value pair in the given unit, or nil if the dimensions of the page are unknown.
+func (kv *KeyValue) BoundingBoxIn(unit Unit) *BoundingBox {
+	bb := kv.BoundingBox()
+	if bb == nil || kv.page == nil {
+		return nil
+	}
+
+	return bb.Convert(kv.page.dimensions, UnitNormalized, unit)
+}
+
+// PolygonIn returns the polygon of the key-value pair in the given unit, or nil if the dimensions of the page are unknown.
+func (kv *KeyValue) PolygonIn(unit Unit) Polygon {
+	if kv.page == nil {
+		return nil
+	}
+
+	return kv.Polygon().Convert(kv.page.dimensions, UnitNormalized, unit)
+}
+
+// parts returns the geometry of the key and the value, skipping the missing ones.
+func (kv *KeyValue) parts() []*base {
+	parts := make([]*base, 0, 2)
+
+	if kv.Key() != nil {
+		parts = append(parts, &kv.Key().base)
+	}
+
+	if kv.Value() != nil {
+		parts = append(parts, &kv.Value().base)
+	}
+
+	return parts
 }
 
 // Words returns the words in the key-value pair.
@@ -91,7 +149,7 @@ func (kv *KeyValue) Text(optFns ...func(*TextLinearizationOptions)) string {
 	keyText := kv.Key().Text()
 	keyText = fmt.Sprintf("%s%s%s", opts.KeyPrefix, keyText, opts.KeySuffix)
 
-	valueText := kv.Value().Text()
+	valueText := kv.Value().Text(optFns...)
 	valueText = fmt.Sprintf("%s%s%s", opts.ValuePrefix, valueText, opts.ValueSuffix)
 
 	if len(keyText) == 0 && len(valueText) == 0 {
@@ -32,6 +32,48 @@ func TestKeyValue(t *testing.T) {
 		assert.Equal(t, expectedConfidence, kv.Confidence())
 	})
 
+	t.Run("Polygon method", func(t *testing.T) {
+		// Setup
+		key := &Key{base: base{boundingBox: &BoundingBox{left: 0.1, top: 0.2, width: 0.2, height: 0.1}}}
+		value := &Value{base: base{boundingBox: &BoundingBox{left: 0.4, top: 0.25, width: 0.3, height: 0.1}}}
+		kv := &KeyValue{key: key, value: value}
+
+		// Test Polygon() method
+		polygon := kv.Polygon()
+		assert.Len(t, polygon, 4)
+		assert.InDelta(t, 0.1, polygon[0].X(), 1e-9)
+		assert.InDelta(t, 0.2, polygon[0].Y(), 1e-9)
+		assert.InDelta(t, 0.7, polygon[2].X(), 1e-9)
+		assert.InDelta(t, 0.35, polygon[2].Y(), 1e-9)
+	})
+
+	t.Run("Polygon method with polygons", func(t *testing.T) {
+		// Setup
+		key := &Key{base: base{polygon: Polygon{{x: 0.1, y: 0.2}, {x: 0.3, y: 0.2}, {x: 0.3, y: 0.3}, {x: 0.1, y: 0.3}}}}
+		value := &Value{base: base{polygon: Polygon{{x: 0.4, y: 0.25}, {x: 0.7, y: 0.25}, {x: 0.7, y: 0.35}, {x: 0.4, y: 0.35}}}}
+		kv := &KeyValue{key: key, value: value}
+
+		// Test Polygon() method
+		polygon := kv.Polygon()
+		assert.Len(t, polygon, 6)
+		assert.Equal(t, &Point{x: 0.1, y: 0.2}, polygon[0])
+		assert.InDelta(t, 0.0725, polygon.Area(), 1e-9)
+		assert.True(t, polygon.Contains(key.Polygon()))
+		assert.True(t, polygon.Contains(value.Polygon()))
+	})
+
+	t.Run("Polygon method without value", func(t *testing.T) {
+		// Setup
+		key := &Key{base: base{boundingBox: &BoundingBox{left: 0.1, top: 0.2, width: 0.2, height: 0.1}}}
+		kv := &KeyValue{key: key}
+
+		// Test Polygon() method
+		assert.NotPanics(t, func() {
+			assert.Len(t, kv.Polygon(), 4)
+		})
+		assert.Nil(t, (&KeyValue{}).Polygon())
+	})
+
 	t.Run("OCRConfidence method", func(t *testing.T) {
 		// Setup
 		key := &Key{words: []*Word{{base: base{confidence: 0.8}}, {base: base{confidence: 0.9}}}}
@@ -25,6 +25,19 @@ func (l *Layout) AddChildren(children ...LayoutChild) {
 	l.children = append(l.children, children...)
 }
 
+// updatePolygon recomputes the polygon of the layout from its children.
+func (l *Layout) updatePolygon() {
+	accessors := make([]PolygonAccessor, 0, len(l.children))
+
+	for _, c := range l.children {
+		if pa, ok := c.(PolygonAccessor); ok {
+			accessors = append(accessors, pa)
+		}
+	}
+
+	l.polygon = NewEnclosingPolygon(accessors...)
+}
+
 func (l *Layout) Text(optFns ...func(*TextLinearizationOptions)) string {
 	opts := DefaultLinerizationOptions
 
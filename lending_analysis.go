@@ -0,0 +1,374 @@
+package textractor
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/textract/types"
+)
+
+// LendingAnalysis represents the result of a Textract lending analysis of a mortgage packet.
+type LendingAnalysis struct {
+	document *Document
+	pages    []*LendingPage
+	summary  *LendingSummary
+	report   *ParseReport
+}
+
+// ParseReport returns the problems found while parsing the lending analysis.
+func (la *LendingAnalysis) ParseReport() *ParseReport {
+	if la.report == nil {
+		return &ParseReport{}
+	}
+
+	return la.report
+}
+
+// Document returns the block document linked to the lending pages, if blocks were provided.
+func (la *LendingAnalysis) Document() *Document {
+	return la.document
+}
+
+// Pages returns the classified lending pages.
+func (la *LendingAnalysis) Pages() []*LendingPage {
+	return la.pages
+}
+
+// Summary returns the lending summary, if one was provided.
+func (la *LendingAnalysis) Summary() *LendingSummary {
+	return la.summary
+}
+
+// PageTypes returns the distinct page types in order of their first occurrence.
+func (la *LendingAnalysis) PageTypes() []string {
+	var pageTypes []string
+
+	seen := make(map[string]bool)
+
+	for _, p := range la.pages {
+		if !seen[p.PageType()] {
+			seen[p.PageType()] = true
+			pageTypes = append(pageTypes, p.PageType())
+		}
+	}
+
+	return pageTypes
+}
+
+// PagesByType returns all lending pages classified as the given page type (e.g. PAYSLIPS).
+func (la *LendingAnalysis) PagesByType(pageType string) []*LendingPage {
+	var pages []*LendingPage
+
+	for _, p := range la.pages {
+		if p.PageType() == pageType {
+			pages = append(pages, p)
+		}
+	}
+
+	return pages
+}
+
+// LendingFields returns the lending fields of all pages.
+func (la *LendingAnalysis) LendingFields() []*LendingField {
+	var fields []*LendingField
+
+	for _, p := range la.pages {
+		fields = append(fields, p.LendingFields()...)
+	}
+
+	return fields
+}
+
+// LendingPage represents a single classified page of a lending analysis.
+type LendingPage struct {
+	pageNumber        int
+	pageType          *Prediction
+	pageTypes         []*Prediction
+	pageNumberInType  *Prediction
+	lendingFields     []*LendingField
+	signatures        []*LendingSignature
+	expenseDocuments  []*ExpenseDocument
+	identityDocuments []*IdentityDocument
+	page              *Page
+	raw               types.LendingResult
+}
+
+// PageNumber returns the page number with regard to the whole submission.
+func (lp *LendingPage) PageNumber() int {
+	return lp.pageNumber
+}
+
+// PageType returns the most confident page type (e.g. PAYSLIPS), or UNKNOWN if the page was not classified.
+func (lp *LendingPage) PageType() string {
+	if lp.pageType == nil {
+		return LendingPageTypeUnknown
+	}
+
+	return lp.pageType.Value()
+}
+
+// PageTypeConfidence returns the confidence of the most confident page type.
+func (lp *LendingPage) PageTypeConfidence() float64 {
+	if lp.pageType == nil {
+		return 0
+	}
+
+	return lp.pageType.Confidence()
+}
+
+// PageTypePredictions returns all page type predictions.
+func (lp *LendingPage) PageTypePredictions() []*Prediction {
+	return lp.pageTypes
+}
+
+// PageNumberInType returns the predicted page number within its document type, if any.
+func (lp *LendingPage) PageNumberInType() *Prediction {
+	return lp.pageNumberInType
+}
+
+// LendingFields returns the lending fields extracted from the page.
+func (lp *LendingPage) LendingFields() []*LendingField {
+	return lp.lendingFields
+}
+
+// LendingFieldByType returns the first lending field of the given type, or nil if there is none.
+func (lp *LendingPage) LendingFieldByType(fieldType string) *LendingField {
+	for _, f := range lp.lendingFields {
+		if f.FieldType() == fieldType {
+			return f
+		}
+	}
+
+	return nil
+}
+
+// Signatures returns the signatures detected on the page.
+func (lp *LendingPage) Signatures() []*LendingSignature {
+	return lp.signatures
+}
+
+// ExpenseDocuments returns the expense documents extracted from the page.
+func (lp *LendingPage) ExpenseDocuments() []*ExpenseDocument {
+	return lp.expenseDocuments
+}
+
+// IdentityDocuments returns the identity documents extracted from the page.
+func (lp *LendingPage) IdentityDocuments() []*IdentityDocument {
+	return lp.identityDocuments
+}
+
+// Page returns the linked block page, or nil if no blocks were provided.
+func (lp *LendingPage) Page() *Page {
+	return lp.page
+}
+
+// Raw returns the raw lending result.
+func (lp *LendingPage) Raw() types.LendingResult {
+	return lp.raw
+}
+
+// Prediction represents a predicted value together with its confidence.
+type Prediction struct {
+	value      string
+	confidence float64
+}
+
+// Value returns the predicted value.
+func (p *Prediction) Value() string {
+	return p.value
+}
+
+// Confidence returns the confidence of the prediction.
+func (p *Prediction) Confidence() float64 {
+	return p.confidence
+}
+
+// LendingField represents a normalized key-value pair extracted from a lending document.
+type LendingField struct {
+	fieldType  string
+	key        *LendingDetection
+	values     []*LendingDetection
+	pageNumber int
+}
+
+// FieldType returns the type of the lending field (e.g. PAYSLIP_GROSS_PAY_YTD).
+func (lf *LendingField) FieldType() string {
+	return lf.fieldType
+}
+
+// Key returns the detected key of the field, if any.
+func (lf *LendingField) Key() *LendingDetection {
+	return lf.key
+}
+
+// Values returns all detected values of the field.
+func (lf *LendingField) Values() []*LendingDetection {
+	return lf.values
+}
+
+// Value returns the most confident value of the field, or nil if there is none.
+func (lf *LendingField) Value() *LendingDetection {
+	var top *LendingDetection
+
+	for _, v := range lf.values {
+		if top == nil || v.Confidence() > top.Confidence() {
+			top = v
+		}
+	}
+
+	return top
+}
+
+// PageNumber returns the page number the field was detected on.
+func (lf *LendingField) PageNumber() int {
+	return lf.pageNumber
+}
+
+// String returns the string representation of the lending field.
+func (lf *LendingField) String() string {
+	if v := lf.Value(); v != nil {
+		return lf.fieldType + ": " + v.Text()
+	}
+
+	return lf.fieldType
+}
+
+// LendingDetection represents a key or value detected in a lending document.
+type LendingDetection struct {
+	text            string
+	confidence      float64
+	selectionStatus types.SelectionStatus
+	boundingBox     *BoundingBox
+	polygon         Polygon
+}
+
+// Text returns the detected text.
+func (ld *LendingDetection) Text() string {
+	return ld.text
+}
+
+// Confidence returns the confidence score of the detection.
+func (ld *LendingDetection) Confidence() float64 {
+	return ld.confidence
+}
+
+// SelectionStatus returns the selection status if the detection is a selection element.
+func (ld *LendingDetection) SelectionStatus() types.SelectionStatus {
+	return ld.selectionStatus
+}
+
+// IsSelected checks if the detection is a selected selection element.
+func (ld *LendingDetection) IsSelected() bool {
+	return ld.selectionStatus == types.SelectionStatusSelected
+}
+
+// BoundingBox returns the bounding box of the detection.
+func (ld *LendingDetection) BoundingBox() *BoundingBox {
+	return ld.boundingBox
+}
+
+// Polygon returns the polygon of the detection.
+func (ld *LendingDetection) Polygon() Polygon {
+	return ld.polygon
+}
+
+// String returns the string representation of the detection.
+func (ld *LendingDetection) String() string {
+	return ld.Text()
+}
+
+// LendingSignature represents a signature detected in a lending document.
+type LendingSignature struct {
+	confidence  float64
+	boundingBox *BoundingBox
+	polygon     Polygon
+	pageNumber  int
+}
+
+// Confidence returns the confidence score of the signature detection.
+func (ls *LendingSignature) Confidence() float64 {
+	return ls.confidence
+}
+
+// BoundingBox returns the bounding box of the signature.
+func (ls *LendingSignature) BoundingBox() *BoundingBox {
+	return ls.boundingBox
+}
+
+// Polygon returns the polygon of the signature.
+func (ls *LendingSignature) Polygon() Polygon {
+	return ls.polygon
+}
+
+// PageNumber returns the page number the signature was detected on.
+func (ls *LendingSignature) PageNumber() int {
+	return ls.pageNumber
+}
+
+// LendingSummary summarizes the document groups of a lending analysis.
+type LendingSummary struct {
+	documentGroups          []*LendingDocumentGroup
+	undetectedDocumentTypes []string
+}
+
+// DocumentGroups returns the document groups.
+func (ls *LendingSummary) DocumentGroups() []*LendingDocumentGroup {
+	return ls.documentGroups
+}
+
+// DocumentGroupByType returns the document group of the given type, or nil if there is none.
+func (ls *LendingSummary) DocumentGroupByType(groupType string) *LendingDocumentGroup {
+	for _, g := range ls.documentGroups {
+		if g.GroupType() == groupType {
+			return g
+		}
+	}
+
+	return nil
+}
+
+// UndetectedDocumentTypes returns the expected document types that were not found.
+func (ls *LendingSummary) UndetectedDocumentTypes() []string {
+	return ls.undetectedDocumentTypes
+}
+
+// LendingDocumentGroup represents all documents of the same type in a lending analysis.
+type LendingDocumentGroup struct {
+	groupType                string
+	splitDocuments           []*SplitDocument
+	detectedSignaturePages   []int
+	undetectedSignaturePages []int
+}
+
+// GroupType returns the document type of the group (e.g. PAYSLIPS).
+func (ldg *LendingDocumentGroup) GroupType() string {
+	return ldg.groupType
+}
+
+// SplitDocuments returns the logical documents of the group.
+func (ldg *LendingDocumentGroup) SplitDocuments() []*SplitDocument {
+	return ldg.splitDocuments
+}
+
+// DetectedSignaturePages returns the pages on which signatures were detected.
+func (ldg *LendingDocumentGroup) DetectedSignaturePages() []int {
+	return ldg.detectedSignaturePages
+}
+
+// UndetectedSignaturePages returns the pages on which signatures were expected but not found.
+func (ldg *LendingDocumentGroup) UndetectedSignaturePages() []int {
+	return ldg.undetectedSignaturePages
+}
+
+// SplitDocument represents a logical document within a document group.
+type SplitDocument struct {
+	index int
+	pages []int
+}
+
+// Index returns the index of the document within its group.
+func (sd *SplitDocument) Index() int {
+	return sd.index
+}
+
+// Pages returns the page numbers of the document, ordered by logical boundary.
+func (sd *SplitDocument) Pages() []int {
+	return sd.pages
+}
@@ -0,0 +1,216 @@
+package textractor
+
+import (
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/textract/types"
+)
+
+type lendingAnalysisParser struct {
+	results []types.LendingResult
+	summary *types.LendingSummary
+	blocks  []types.Block
+}
+
+func newLendingAnalysisParser(output *LendingAnalysisOutput) *lendingAnalysisParser {
+	return &lendingAnalysisParser{
+		results: output.Results,
+		summary: output.Summary,
+		blocks:  output.Blocks,
+	}
+}
+
+func (lap *lendingAnalysisParser) createLendingAnalysis() *LendingAnalysis {
+	document := lap.createDocument()
+
+	report := &ParseReport{}
+	if document != nil {
+		report.warnings = append(report.warnings, document.report.warnings...)
+	}
+
+	return &LendingAnalysis{
+		document: document,
+		pages:    lap.createPages(document),
+		summary:  lap.createSummary(),
+		report:   report,
+	}
+}
+
+func (lap *lendingAnalysisParser) createDocument() *Document {
+	if len(lap.blocks) == 0 {
+		return nil
+	}
+
+	parser := newBlockParser(lap.blocks)
+
+	return parser.createDocument()
+}
+
+func (lap *lendingAnalysisParser) createPages(document *Document) []*LendingPage {
+	numberPageMap := make(map[int]*Page)
+
+	if document != nil {
+		for _, p := range document.Pages() {
+			numberPageMap[p.Number()] = p
+		}
+	}
+
+	pages := make([]*LendingPage, len(lap.results))
+
+	for i, r := range lap.results {
+		pageNumber := int(aws.ToInt32(r.Page))
+
+		page := &LendingPage{
+			pageNumber: pageNumber,
+			page:       numberPageMap[pageNumber],
+			raw:        r,
+		}
+
+		if pc := r.PageClassification; pc != nil {
+			page.pageTypes = newPredictions(pc.PageType)
+			if len(page.pageTypes) > 0 {
+				page.pageType = page.pageTypes[0]
+			}
+
+			if pageNumbers := newPredictions(pc.PageNumber); len(pageNumbers) > 0 {
+				page.pageNumberInType = pageNumbers[0]
+			}
+		}
+
+		for _, e := range r.Extractions {
+			if e.LendingDocument != nil {
+				for _, f := range e.LendingDocument.LendingFields {
+					page.lendingFields = append(page.lendingFields, newLendingField(f, pageNumber))
+				}
+
+				for _, s := range e.LendingDocument.SignatureDetections {
+					signature := &LendingSignature{
+						confidence: float64(aws.ToFloat32(s.Confidence)),
+						pageNumber: pageNumber,
+					}
+
+					if s.Geometry != nil {
+						signature.boundingBox = newBoundingBox(s.Geometry.BoundingBox)
+						signature.polygon = newPolygon(s.Geometry.Polygon)
+					}
+
+					page.signatures = append(page.signatures, signature)
+				}
+			}
+
+			if e.ExpenseDocument != nil {
+				parser := newExpenseDocumentParser(*e.ExpenseDocument)
+				page.expenseDocuments = append(page.expenseDocuments, parser.createExpenseDocument())
+			}
+
+			if e.IdentityDocument != nil {
+				parser := newIdentityDocumentParser(*e.IdentityDocument)
+				page.identityDocuments = append(page.identityDocuments, parser.createIdentityDocument())
+			}
+		}
+
+		pages[i] = page
+	}
+
+	sort.SliceStable(pages, func(i, j int) bool {
+		return pages[i].pageNumber < pages[j].pageNumber
+	})
+
+	return pages
+}
+
+func (lap *lendingAnalysisParser) createSummary() *LendingSummary {
+	if lap.summary == nil {
+		return nil
+	}
+
+	groups := make([]*LendingDocumentGroup, len(lap.summary.DocumentGroups))
+
+	for i, g := range lap.summary.DocumentGroups {
+		group := &LendingDocumentGroup{
+			groupType: aws.ToString(g.Type),
+		}
+
+		for _, sd := range g.SplitDocuments {
+			pages := make([]int, len(sd.Pages))
+			for j, p := range sd.Pages {
+				pages[j] = int(p)
+			}
+
+			group.splitDocuments = append(group.splitDocuments, &SplitDocument{
+				index: int(aws.ToInt32(sd.Index)),
+				pages: pages,
+			})
+		}
+
+		for _, s := range g.DetectedSignatures {
+			group.detectedSignaturePages = append(group.detectedSignaturePages, int(aws.ToInt32(s.Page)))
+		}
+
+		for _, s := range g.UndetectedSignatures {
+			group.undetectedSignaturePages = append(group.undetectedSignaturePages, int(aws.ToInt32(s.Page)))
+		}
+
+		groups[i] = group
+	}
+
+	return &LendingSummary{
+		documentGroups:          groups,
+		undetectedDocumentTypes: lap.summary.UndetectedDocumentTypes,
+	}
+}
+
+// newPredictions creates predictions from the provided Textract predictions, sorted from most to least confident.
+func newPredictions(ps []types.Prediction) []*Prediction {
+	predictions := make([]*Prediction, len(ps))
+
+	for i, p := range ps {
+		predictions[i] = &Prediction{
+			value:      aws.ToString(p.Value),
+			confidence: float64(aws.ToFloat32(p.Confidence)),
+		}
+	}
+
+	sort.SliceStable(predictions, func(i, j int) bool {
+		return predictions[j].confidence < predictions[i].confidence
+	})
+
+	return predictions
+}
+
+// newLendingField creates a new LendingField instance from the provided Textract lending field.
+func newLendingField(f types.LendingField, pageNumber int) *LendingField {
+	field := &LendingField{
+		fieldType:  aws.ToString(f.Type),
+		key:        newLendingDetection(f.KeyDetection),
+		values:     make([]*LendingDetection, 0, len(f.ValueDetections)),
+		pageNumber: pageNumber,
+	}
+
+	for i := range f.ValueDetections {
+		field.values = append(field.values, newLendingDetection(&f.ValueDetections[i]))
+	}
+
+	return field
+}
+
+// newLendingDetection creates a new LendingDetection instance from the provided Textract lending detection.
+func newLendingDetection(d *types.LendingDetection) *LendingDetection {
+	if d == nil {
+		return nil
+	}
+
+	detection := &LendingDetection{
+		text:            aws.ToString(d.Text),
+		confidence:      float64(aws.ToFloat32(d.Confidence)),
+		selectionStatus: d.SelectionStatus,
+	}
+
+	if d.Geometry != nil {
+		detection.boundingBox = newBoundingBox(d.Geometry.BoundingBox)
+		detection.polygon = newPolygon(d.Geometry.Polygon)
+	}
+
+	return detection
+}
@@ -0,0 +1,221 @@
+package textractor
+
+import (
+	"cmp"
+	"encoding/csv"
+	"io"
+	"slices"
+	"strings"
+)
+
+// LogicalTablesOptions defines how tables continuing across pages are detected.
+type LogicalTablesOptions struct {
+	// PageEdgeThreshold is the maximum distance of a table to the bottom of its page, and of the
+	// continuation table to the top of the next page, for the two to be merged.
+	PageEdgeThreshold float64
+
+	// ContinuationMarkers are case-insensitive texts in table titles and footers (e.g. "continued")
+	// that mark a table as continuing on the next page.
+	ContinuationMarkers []string
+}
+
+// LogicalTable represents a table that may span multiple pages. It consists of one or more
+// page-level tables with the same columns.
+type LogicalTable struct {
+	tables []*Table
+}
+
+// Tables returns the page-level tables the logical table consists of.
+func (lt *LogicalTable) Tables() []*Table {
+	return lt.tables
+}
+
+// Pages returns the pages the logical table spans.
+func (lt *LogicalTable) Pages() []*Page {
+	pages := make([]*Page, 0, len(lt.tables))
+
+	for _, t := range lt.tables {
+		if !slices.Contains(pages, t.page) {
+			pages = append(pages, t.page)
+		}
+	}
+
+	return pages
+}
+
+// Title returns the title of the first table, if any.
+func (lt *LogicalTable) Title() *TableTitle {
+	return lt.tables[0].Title()
+}
+
+// Header returns the column names of the first table.
+func (lt *LogicalTable) Header() []string {
+	return lt.tables[0].Header()
+}
+
+// ColumnCount returns the number of columns of the logical table.
+func (lt *LogicalTable) ColumnCount() int {
+	return lt.tables[0].ColumnCount()
+}
+
+// Rows returns the rows of all tables. Header rows repeated by continuation tables are skipped.
+func (lt *LogicalTable) Rows() []*LogicalTableRow {
+	var rows []*LogicalTableRow
+
+	for i, t := range lt.tables {
+		for _, r := range t.Rows() {
+			if i > 0 && r.IsColumnHeader() {
+				continue
+			}
+
+			rows = append(rows, &LogicalTableRow{TableRow: r, table: t})
+		}
+	}
+
+	return rows
+}
+
+// DataRows returns the rows that are neither header, summary nor section title rows.
+func (lt *LogicalTable) DataRows() []*LogicalTableRow {
+	var rows []*LogicalTableRow
+
+	for _, r := range lt.Rows() {
+		if !r.IsColumnHeader() && !r.IsTableSummary() && !r.IsTableSectionTitle() {
+			rows = append(rows, r)
+		}
+	}
+
+	return rows
+}
+
+// ToCSV writes the logical table as CSV. If the first table has no header rows, its first row is used as header.
+func (lt *LogicalTable) ToCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+
+	defer cw.Flush()
+
+	header, data := lt.tables[0].headerAndData()
+
+	for _, t := range lt.tables[1:] {
+		for _, r := range t.Rows() {
+			if !r.IsColumnHeader() {
+				data = append(data, t.rowTexts(r.Index()))
+			}
+		}
+	}
+
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+
+	return cw.WriteAll(data)
+}
+
+// LogicalTableRow represents a row of a logical table together with the table and page it originates from.
+type LogicalTableRow struct {
+	*TableRow
+	table *Table
+}
+
+// Table returns the page-level table the row originates from.
+func (ltr *LogicalTableRow) Table() *Table {
+	return ltr.table
+}
+
+// Page returns the page the row originates from.
+func (ltr *LogicalTableRow) Page() *Page {
+	return ltr.table.page
+}
+
+// LogicalTables returns the tables of the document, merging tables that continue on the next page
+// into a single logical table. A table is merged with the first table of the next page if both have
+// the same number of columns, are located near the page boundary, the continuation table has no
+// header or repeats the header, and its title (if any) matches or is marked as continuation.
+func (d *Document) LogicalTables(optFns ...func(*LogicalTablesOptions)) []*LogicalTable {
+	opts := LogicalTablesOptions{
+		PageEdgeThreshold:   0.2,
+		ContinuationMarkers: []string{"continued", "cont'd", "contd"},
+	}
+
+	for _, fn := range optFns {
+		fn(&opts)
+	}
+
+	var (
+		logicalTables []*LogicalTable
+		prev          *LogicalTable
+	)
+
+	for _, p := range d.Pages() {
+		tables := sortedTables(p.Tables())
+
+		for i, t := range tables {
+			if i == 0 && prev != nil && isContinuation(prev.tables[len(prev.tables)-1], t, opts) {
+				prev.tables = append(prev.tables, t)
+				continue
+			}
+
+			prev = &LogicalTable{tables: []*Table{t}}
+			logicalTables = append(logicalTables, prev)
+		}
+
+		if len(tables) == 0 {
+			prev = nil
+		}
+	}
+
+	return logicalTables
+}
+
+// isContinuation checks if next continues the table prev on the following page.
+func isContinuation(prev, next *Table, opts LogicalTablesOptions) bool {
+	if prev.page == next.page || prev.ColumnCount() != next.ColumnCount() {
+		return false
+	}
+
+	if next.BoundingBox().Top() > opts.PageEdgeThreshold {
+		return false
+	}
+
+	// A continuation marker in the footer overrides the position of the table on its page
+	if !slices.ContainsFunc(prev.footers, func(f *TableFooter) bool {
+		return hasContinuationMarker(f.Text(), opts.ContinuationMarkers)
+	}) && prev.BoundingBox().Bottom() < 1-opts.PageEdgeThreshold {
+		return false
+	}
+
+	if header := next.Header(); header != nil && !slices.Equal(header, prev.Header()) {
+		return false
+	}
+
+	if next.title != nil {
+		title := next.title.Text()
+
+		if !hasContinuationMarker(title, opts.ContinuationMarkers) &&
+			(prev.title == nil || !strings.EqualFold(title, prev.title.Text())) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// hasContinuationMarker checks if the text contains one of the continuation markers.
+func hasContinuationMarker(text string, markers []string) bool {
+	text = strings.ToLower(text)
+
+	return slices.ContainsFunc(markers, func(m string) bool {
+		return strings.Contains(text, strings.ToLower(m))
+	})
+}
+
+// sortedTables returns a copy of the tables sorted by their top coordinate.
+func sortedTables(tables []*Table) []*Table {
+	sorted := slices.Clone(tables)
+
+	slices.SortStableFunc(sorted, func(a, b *Table) int {
+		return cmp.Compare(a.BoundingBox().Top(), b.BoundingBox().Top())
+	})
+
+	return sorted
+}
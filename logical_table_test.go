@@ -0,0 +1,131 @@
+package textractor
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/textract/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogicalTables(t *testing.T) {
+	newTable := func(page *Page, top, bottom float64, header []string, rows ...[]string) *Table {
+		table := &Table{
+			base: base{boundingBox: &BoundingBox{top: top, height: bottom - top, left: 0.1, width: 0.8}, page: page},
+		}
+
+		rowIndex := 1
+
+		if header != nil {
+			for i, h := range header {
+				table.cells = append(table.cells, &TableCell{cell: newTestCell(rowIndex, i+1, 1, 1, types.EntityTypeColumnHeader), words: []*Word{{text: h}}})
+			}
+
+			rowIndex++
+		}
+
+		for _, r := range rows {
+			for i, text := range r {
+				table.cells = append(table.cells, &TableCell{cell: newTestCell(rowIndex, i+1, 1, 1), words: []*Word{{text: text}}})
+			}
+
+			rowIndex++
+		}
+
+		page.tables = append(page.tables, table)
+
+		return table
+	}
+
+	t.Run("RepeatedHeader", func(t *testing.T) {
+		page1, page2, page3 := &Page{number: 1}, &Page{number: 2}, &Page{number: 3}
+
+		t1 := newTable(page1, 0.5, 0.95, []string{"Date", "Amount"}, []string{"01/01", "10"}, []string{"01/02", "20"})
+		t2 := newTable(page2, 0.05, 0.9, []string{"Date", "Amount"}, []string{"01/03", "30"})
+		t3 := newTable(page3, 0.05, 0.3, []string{"Name", "Value"}, []string{"a", "1"})
+
+		doc := &Document{pages: []*Page{page1, page2, page3}}
+
+		tables := doc.LogicalTables()
+		assert.Equal(t, 2, len(tables))
+		assert.Equal(t, []*Table{t1, t2}, tables[0].Tables())
+		assert.Equal(t, []*Page{page1, page2}, tables[0].Pages())
+		assert.Equal(t, []string{"Date", "Amount"}, tables[0].Header())
+		assert.Equal(t, []*Table{t3}, tables[1].Tables())
+
+		rows := tables[0].Rows()
+		assert.Equal(t, 4, len(rows))
+		assert.Equal(t, []string{"01/03", "30"}, rows[3].Texts())
+		assert.Equal(t, t2, rows[3].Table())
+		assert.Equal(t, page2, rows[3].Page())
+
+		dataRows := tables[0].DataRows()
+		assert.Equal(t, 3, len(dataRows))
+		assert.Equal(t, page1, dataRows[0].Page())
+
+		sb := &strings.Builder{}
+		assert.NoError(t, tables[0].ToCSV(sb))
+		assert.Equal(t, "Date,Amount\n01/01,10\n01/02,20\n01/03,30\n", sb.String())
+	})
+
+	t.Run("WithoutHeader", func(t *testing.T) {
+		page1, page2 := &Page{number: 1}, &Page{number: 2}
+
+		newTable(page1, 0.5, 0.95, []string{"Date", "Amount"}, []string{"01/01", "10"})
+		newTable(page2, 0.1, 0.9, nil, []string{"01/02", "20"})
+
+		doc := &Document{pages: []*Page{page1, page2}}
+
+		tables := doc.LogicalTables()
+		assert.Equal(t, 1, len(tables))
+		assert.Equal(t, 3, len(tables[0].Rows()))
+	})
+
+	t.Run("NotNearPageBoundary", func(t *testing.T) {
+		page1, page2 := &Page{number: 1}, &Page{number: 2}
+
+		newTable(page1, 0.1, 0.5, []string{"Date", "Amount"}, []string{"01/01", "10"})
+		newTable(page2, 0.1, 0.9, nil, []string{"01/02", "20"})
+
+		doc := &Document{pages: []*Page{page1, page2}}
+
+		assert.Equal(t, 2, len(doc.LogicalTables()))
+		assert.Equal(t, 1, len(doc.LogicalTables(func(o *LogicalTablesOptions) {
+			o.PageEdgeThreshold = 0.5
+		})))
+	})
+
+	t.Run("ContinuationFooter", func(t *testing.T) {
+		page1, page2 := &Page{number: 1}, &Page{number: 2}
+
+		t1 := newTable(page1, 0.1, 0.5, []string{"Date", "Amount"}, []string{"01/01", "10"})
+		t1.footers = []*TableFooter{{words: []*Word{{text: "Continued"}, {text: "on"}, {text: "next"}, {text: "page"}}}}
+
+		newTable(page2, 0.1, 0.9, nil, []string{"01/02", "20"})
+
+		doc := &Document{pages: []*Page{page1, page2}}
+
+		assert.Equal(t, 1, len(doc.LogicalTables()))
+	})
+
+	t.Run("DifferentColumnCountOrTitle", func(t *testing.T) {
+		page1, page2, page3 := &Page{number: 1}, &Page{number: 2}, &Page{number: 3}
+
+		newTable(page1, 0.5, 0.95, []string{"Date", "Amount"}, []string{"01/01", "10"})
+		t2 := newTable(page2, 0.05, 0.95, nil, []string{"01/02", "20", "x"})
+		t3 := newTable(page3, 0.05, 0.5, nil, []string{"01/03", "30", "y"})
+		t3.title = &TableTitle{words: []*Word{{text: "Liabilities"}}}
+
+		doc := &Document{pages: []*Page{page1, page2, page3}}
+
+		tables := doc.LogicalTables()
+		assert.Equal(t, 3, len(tables))
+		assert.Equal(t, []*Table{t2}, tables[1].Tables())
+
+		t3.title = &TableTitle{words: []*Word{{text: "Liabilities"}, {text: "(continued)"}}}
+
+		tables = doc.LogicalTables()
+		assert.Equal(t, 2, len(tables))
+		assert.Equal(t, []*Table{t2, t3}, tables[1].Tables())
+	})
+}
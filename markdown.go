@@ -0,0 +1,133 @@
+package textractor
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/textract/types"
+)
+
+// Markdown exports the document as Markdown. It starts from MarkdownLinerizationOptions,
+// which can be adjusted by the provided option functions.
+func (d *Document) Markdown(optFns ...func(*TextLinearizationOptions)) string {
+	pageTexts := make([]string, 0, len(d.Pages()))
+
+	for _, p := range d.Pages() {
+		if text := p.Markdown(optFns...); text != "" {
+			pageTexts = append(pageTexts, text)
+		}
+	}
+
+	return strings.Join(pageTexts, "\n\n")
+}
+
+// Markdown exports the page as Markdown. Titles and section headers become headings, lists
+// become bullet lists, tables become Markdown tables, key-value pairs become definition lists
+// and selection elements become task list items.
+func (p *Page) Markdown(optFns ...func(*TextLinearizationOptions)) string {
+	opts := MarkdownLinerizationOptions
+
+	for _, fn := range optFns {
+		fn(&opts)
+	}
+
+	blocks := make([]string, 0, len(p.layouts))
+
+	for _, l := range p.sortedLayouts(opts.ReadingOrder) {
+		var text string
+
+		if l.BlockType() == types.BlockTypeLayoutKeyValue {
+			text = l.markdownKeyValues(opts)
+		} else {
+			text = l.Text(func(tlo *TextLinearizationOptions) {
+				*tlo = opts
+			})
+		}
+
+		if text = strings.TrimSpace(text); text != "" {
+			blocks = append(blocks, text)
+		}
+	}
+
+	if opts.ShowQueries {
+		if text := queriesText(p.queries, opts); text != "" {
+			blocks = append(blocks, opts.QueryLayoutPrefix+text+opts.QueryLayoutSuffix)
+		}
+	}
+
+	return strings.Join(blocks, "\n\n")
+}
+
+// markdownKeyValues renders the children of a key-value layout as definition and task list items.
+func (l *Layout) markdownKeyValues(opts TextLinearizationOptions) string {
+	var (
+		text     string
+		prevTask bool
+	)
+
+	for i, group := range groupElementsHorizontally(l.children, opts.HeuristicOverlapRatio) {
+		sort.Slice(group, func(i, j int) bool {
+			return group[i].BoundingBox().Left() < group[j].BoundingBox().Left()
+		})
+
+		for j, child := range group {
+			var (
+				childText string
+				task      bool
+			)
+
+			if kv, ok := child.(*KeyValue); ok {
+				childText, task = markdownKeyValue(kv, opts)
+			} else {
+				childText = child.Text(func(tlo *TextLinearizationOptions) {
+					*tlo = opts
+				})
+			}
+
+			if childText == "" {
+				continue
+			}
+
+			if i > 0 || j > 0 {
+				if task && prevTask {
+					text += "\n"
+				} else {
+					text += "\n\n"
+				}
+			}
+
+			text += childText
+			prevTask = task
+		}
+	}
+
+	return text
+}
+
+// markdownKeyValue renders a key-value pair as a task list item if its value is a selection
+// element, and as a definition list item otherwise.
+func markdownKeyValue(kv *KeyValue, opts TextLinearizationOptions) (string, bool) {
+	var keyText string
+	if kv.Key() != nil {
+		keyText = kv.Key().Text()
+	}
+
+	if kv.Value() == nil {
+		return keyText, false
+	}
+
+	if se := kv.Value().SelectionElement(); se != nil {
+		return fmt.Sprintf("- %s %s", se.Text(func(tlo *TextLinearizationOptions) {
+			*tlo = opts
+		}), keyText), true
+	}
+
+	valueText := kv.Value().Text()
+
+	if keyText == "" || valueText == "" {
+		return keyText + valueText, false
+	}
+
+	return fmt.Sprintf("%s%s%s\n: %s%s%s", opts.KeyPrefix, keyText, opts.KeySuffix, opts.ValuePrefix, valueText, opts.ValueSuffix), false
+}
@@ -0,0 +1,72 @@
+package textractor
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/textract/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarkdown(t *testing.T) {
+	t.Run("SimpleTableLayout", func(t *testing.T) {
+		res, err := loadDocumentAPIOutputTestdata("testdata/test-simple-table-layout.json")
+		assert.NoError(t, err)
+
+		doc, err := ParseDocumentAPIOutput(res)
+		assert.NoError(t, err)
+
+		md := doc.Markdown()
+
+		assert.Contains(t, md, "# New Document\n\n## Paragraph 1\n\nLorem ipsum dolor sit amet")
+		assert.Contains(t, md, `| A  |  B  | C  |
+|----|-----|----|
+| A1 | b1  | C1 |`)
+	})
+
+	t.Run("KeyValuesAndSelectionElements", func(t *testing.T) {
+		res, err := loadDocumentAPIOutputTestdata("testdata/test-document.json")
+		assert.NoError(t, err)
+
+		doc, err := ParseDocumentAPIOutput(res)
+		assert.NoError(t, err)
+
+		md := doc.Markdown()
+
+		assert.Contains(t, md, "Date :\n: 08/14/2022")
+		assert.Contains(t, md, "- [x] Selected Checkbox")
+		assert.Contains(t, md, "- [ ] Un-Selected Checkbox")
+	})
+
+	t.Run("Options", func(t *testing.T) {
+		res, err := loadDocumentAPIOutputTestdata("testdata/test-simple-table-layout.json")
+		assert.NoError(t, err)
+
+		doc, err := ParseDocumentAPIOutput(res)
+		assert.NoError(t, err)
+
+		md := doc.Markdown(func(tlo *TextLinearizationOptions) {
+			tlo.TitlePrefix = "## "
+			tlo.SectionHeaderPrefix = "### "
+		})
+
+		assert.Contains(t, md, "## New Document\n\n### Paragraph 1")
+	})
+
+	t.Run("List", func(t *testing.T) {
+		page := &Page{}
+		line1 := &Line{base: base{id: "l1", boundingBox: &BoundingBox{top: 0.1, height: 0.01}}, words: []*Word{{text: "first"}}}
+		line2 := &Line{base: base{id: "l2", boundingBox: &BoundingBox{top: 0.2, height: 0.01}}, words: []*Word{{text: "second"}}}
+
+		list := &Layout{
+			base: base{blockType: types.BlockTypeLayoutList, boundingBox: &BoundingBox{top: 0.1, height: 0.11}},
+			children: []LayoutChild{
+				&Layout{base: base{blockType: types.BlockTypeLayoutText, boundingBox: line1.BoundingBox()}, children: []LayoutChild{line1}, noNewLines: true},
+				&Layout{base: base{blockType: types.BlockTypeLayoutText, boundingBox: line2.BoundingBox()}, children: []LayoutChild{line2}, noNewLines: true},
+			},
+		}
+
+		page.AddLayouts(list)
+
+		assert.Equal(t, "- first\n- second", page.Markdown())
+	})
+}
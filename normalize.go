@@ -0,0 +1,635 @@
+package textractor
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hupe1980/go-textractor/internal"
+)
+
+// NormalizeOptions defines how raw texts are converted into typed values.
+type NormalizeOptions struct {
+	// Types are the types tried in order. The first type the text can be parsed as wins.
+	Types []TypedValueType
+
+	// DayFirst resolves ambiguous numeric dates like 03/04/2024 as day-month-year instead of month-day-year.
+	DayFirst bool
+
+	// DecimalComma resolves ambiguous numbers like 1,234 or 1.234 with the comma as decimal separator.
+	DecimalComma bool
+
+	// DefaultCurrency is the ISO 4217 code assigned to amounts without a currency. It is only used
+	// if TypedValueTypeNumber is not part of Types.
+	DefaultCurrency string
+}
+
+// TypedValue represents a value converted from the raw text of an element.
+type TypedValue struct {
+	valueType   TypedValueType
+	text        string
+	confidence  float64
+	date        time.Time
+	number      float64
+	currency    string
+	phoneNumber string
+	checked     bool
+}
+
+// Type returns the type of the value. TypedValueTypeText is returned if the text could not be parsed.
+func (tv *TypedValue) Type() TypedValueType {
+	return tv.valueType
+}
+
+// Text returns the raw text the value was parsed from.
+func (tv *TypedValue) Text() string {
+	return tv.text
+}
+
+// Confidence returns the parse confidence between 0 and 1. It is lowered for ambiguous texts,
+// e.g. dates that could be read as day-month or month-day.
+func (tv *TypedValue) Confidence() float64 {
+	return tv.confidence
+}
+
+// Date returns the date of a TypedValueTypeDate value.
+func (tv *TypedValue) Date() time.Time {
+	return tv.date
+}
+
+// Number returns the amount of a TypedValueTypeMoney, the percentage of a TypedValueTypePercentage
+// (e.g. 12.5 for 12.5%) or the number of a TypedValueTypeNumber value.
+func (tv *TypedValue) Number() float64 {
+	return tv.number
+}
+
+// Currency returns the ISO 4217 currency code of a TypedValueTypeMoney value.
+func (tv *TypedValue) Currency() string {
+	return tv.currency
+}
+
+// PhoneNumber returns the digits of a TypedValueTypePhoneNumber value, prefixed with + if
+// the number includes a country code.
+func (tv *TypedValue) PhoneNumber() string {
+	return tv.phoneNumber
+}
+
+// IsChecked returns whether a TypedValueTypeCheckbox value is checked.
+func (tv *TypedValue) IsChecked() bool {
+	return tv.checked
+}
+
+// String returns the string representation of the typed value.
+func (tv *TypedValue) String() string {
+	switch tv.valueType {
+	case TypedValueTypeDate:
+		return tv.date.Format("2006-01-02")
+	case TypedValueTypeMoney:
+		return fmt.Sprintf("%s %s", strconv.FormatFloat(tv.number, 'f', 2, 64), tv.currency)
+	case TypedValueTypePercentage:
+		return strconv.FormatFloat(tv.number, 'f', -1, 64) + "%"
+	case TypedValueTypeNumber:
+		return strconv.FormatFloat(tv.number, 'f', -1, 64)
+	case TypedValueTypePhoneNumber:
+		return tv.phoneNumber
+	case TypedValueTypeCheckbox:
+		return strconv.FormatBool(tv.checked)
+	default:
+		return tv.text
+	}
+}
+
+// Normalize converts the text of the value into a typed value. Values with a selection element
+// are returned as checkbox.
+func (v *Value) Normalize(optFns ...func(*NormalizeOptions)) *TypedValue {
+	return normalize(v.Text(), v.selectionElement, optFns...)
+}
+
+// Normalize converts the text of the table cell into a typed value. Cells with a selection element
+// are returned as checkbox.
+func (tc *TableCell) Normalize(optFns ...func(*NormalizeOptions)) *TypedValue {
+	return normalize(tc.Text(), tc.selectionElement, optFns...)
+}
+
+// Normalize converts the answer of the query into a typed value.
+func (qr *QueryResult) Normalize(optFns ...func(*NormalizeOptions)) *TypedValue {
+	return normalize(qr.text, nil, optFns...)
+}
+
+// NormalizeText converts the text into a typed value.
+func NormalizeText(text string, optFns ...func(*NormalizeOptions)) *TypedValue {
+	return normalize(text, nil, optFns...)
+}
+
+// normalize tries the requested types in order and falls back to a TypedValueTypeText value.
+func normalize(text string, se *SelectionElement, optFns ...func(*NormalizeOptions)) *TypedValue {
+	opts := NormalizeOptions{
+		Types: []TypedValueType{
+			TypedValueTypeCheckbox,
+			TypedValueTypeDate,
+			TypedValueTypePercentage,
+			TypedValueTypeMoney,
+			TypedValueTypeNumber,
+			TypedValueTypePhoneNumber,
+		},
+		DayFirst:        false,
+		DecimalComma:    false,
+		DefaultCurrency: "",
+	}
+
+	for _, fn := range optFns {
+		fn(&opts)
+	}
+
+	s := strings.TrimSpace(text)
+
+	for _, t := range opts.Types {
+		var (
+			tv *TypedValue
+			ok bool
+		)
+
+		switch t {
+		case TypedValueTypeCheckbox:
+			if se != nil {
+				tv, ok = &TypedValue{
+					valueType:  TypedValueTypeCheckbox,
+					confidence: se.Confidence() / 100,
+					checked:    se.IsSelected(),
+				}, true
+			} else {
+				tv, ok = parseCheckbox(s)
+			}
+		case TypedValueTypeDate:
+			tv, ok = parseDate(s, opts.DayFirst)
+		case TypedValueTypePercentage:
+			tv, ok = parsePercentage(s, opts.DecimalComma)
+		case TypedValueTypeMoney:
+			tv, ok = parseMoney(s, opts.DecimalComma, opts.DefaultCurrency, slices.Contains(opts.Types, TypedValueTypeNumber))
+		case TypedValueTypeNumber:
+			var n, c float64
+			if n, c, ok = parseNumber(s, opts.DecimalComma); ok {
+				tv = &TypedValue{valueType: TypedValueTypeNumber, confidence: c, number: n}
+			}
+		case TypedValueTypePhoneNumber:
+			tv, ok = parsePhoneNumber(s)
+		}
+
+		if ok {
+			tv.text = text
+			return tv
+		}
+	}
+
+	return &TypedValue{valueType: TypedValueTypeText, text: text}
+}
+
+// checkboxStates maps textual checkbox representations to their state.
+var checkboxStates = map[string]bool{
+	"[x]": true, "[X]": true, "☑": true, "☒": true, "✓": true, "✔": true,
+	"[ ]": false, "[]": false, "☐": false,
+}
+
+// parseCheckbox parses textual checkbox representations like [X] or ☐.
+func parseCheckbox(s string) (*TypedValue, bool) {
+	checked, ok := checkboxStates[s]
+	if !ok {
+		return nil, false
+	}
+
+	return &TypedValue{valueType: TypedValueTypeCheckbox, confidence: 0.9, checked: checked}, true
+}
+
+// numericDatePattern matches dates like 2024-01-31, 31.01.2024 or 01/31/24.
+var numericDatePattern = regexp.MustCompile(`^(\d{1,4})([./-])(\d{1,2})([./-])(\d{1,4})$`)
+
+// monthNames maps English, German, French, Spanish, Italian, Portuguese and Dutch month names
+// and their abbreviations, without diacritics, to months.
+var monthNames = func() map[string]time.Month {
+	names := [12][]string{
+		{"january", "jan", "januar", "janvier", "janv", "enero", "ene", "gennaio", "gen", "janeiro", "januari"},
+		{"february", "feb", "februar", "fevrier", "fevr", "fev", "febrero", "febbraio", "fevereiro", "februari"},
+		{"march", "mar", "marz", "maerz", "mars", "marzo", "marco", "maart", "mrt"},
+		{"april", "apr", "avril", "avr", "abril", "abr", "aprile"},
+		{"may", "mai", "mayo", "maggio", "mag", "maio", "mei"},
+		{"june", "jun", "juni", "juin", "junio", "giugno", "giu", "junho"},
+		{"july", "jul", "juli", "juillet", "juil", "julio", "luglio", "lug", "julho"},
+		{"august", "aug", "aout", "agosto", "ago", "augustus"},
+		{"september", "sep", "sept", "septembre", "septiembre", "setiembre", "settembre", "set", "setembro"},
+		{"october", "oct", "oktober", "okt", "octobre", "octubre", "ottobre", "ott", "outubro", "out"},
+		{"november", "nov", "novembre", "noviembre", "novembro"},
+		{"december", "dec", "dezember", "dez", "decembre", "diciembre", "dic", "dicembre", "dezembro"},
+	}
+
+	m := make(map[string]time.Month)
+
+	for i, n := range names {
+		for _, name := range n {
+			m[name] = time.Month(i + 1)
+		}
+	}
+
+	return m
+}()
+
+// dateFillerWords are words of textual dates that carry no date information.
+var dateFillerWords = map[string]bool{
+	"de": true, "del": true, "of": true, "the": true,
+	"monday": true, "tuesday": true, "wednesday": true, "thursday": true, "friday": true, "saturday": true, "sunday": true,
+	"mon": true, "tue": true, "wed": true, "thu": true, "fri": true, "sat": true, "sun": true,
+}
+
+// dateSeparatorReplacer replaces the separators of textual dates with spaces.
+var dateSeparatorReplacer = strings.NewReplacer(",", " ", ".", " ", "-", " ", "/", " ")
+
+// parseDate parses numeric dates in year-month-day, day.month.year, day/month/year and month/day/year order
+// as well as textual dates with month names like "January 31, 2024" or "31. Januar 2024".
+func parseDate(s string, dayFirst bool) (*TypedValue, bool) {
+	if m := numericDatePattern.FindStringSubmatch(s); m != nil {
+		return parseNumericDate(m[1], m[2], m[3], m[4], m[5], dayFirst)
+	}
+
+	return parseTextualDate(s)
+}
+
+// parseNumericDate parses the parts of a numeric date. Dates that could be read as day-month and
+// month-day are resolved by dayFirst with a lower confidence.
+func parseNumericDate(first, sep1, second, sep2, third string, dayFirst bool) (*TypedValue, bool) {
+	if sep1 != sep2 {
+		return nil, false
+	}
+
+	a, _ := strconv.Atoi(first)
+	b, _ := strconv.Atoi(second)
+	c, _ := strconv.Atoi(third)
+
+	confidence := 1.0
+
+	var year, month, day int
+
+	switch {
+	case len(first) == 4:
+		if len(third) > 2 {
+			return nil, false
+		}
+
+		year, month, day = a, b, c
+	case len(first) > 2:
+		return nil, false
+	case sep1 == ".":
+		year, month, day = c, b, a
+	case a > 12:
+		year, month, day = c, b, a
+	case b > 12:
+		year, month, day = c, a, b
+	default:
+		year, month, day = c, a, b
+		if dayFirst {
+			month, day = b, a
+		}
+
+		if a != b {
+			confidence = 0.7
+		}
+	}
+
+	if len(first) != 4 {
+		switch len(third) {
+		case 2:
+			year = expandYear(year)
+			confidence *= 0.9
+		case 4:
+		default:
+			return nil, false
+		}
+	}
+
+	return newDateValue(year, month, day, confidence)
+}
+
+// parseTextualDate parses dates consisting of a day, a month name and a four digit year in any order.
+func parseTextualDate(s string) (*TypedValue, bool) {
+	var (
+		year, day int
+		month     time.Month
+	)
+
+	for _, token := range strings.Fields(dateSeparatorReplacer.Replace(strings.ToLower(internal.RemoveDiacritics(s)))) {
+		if dateFillerWords[token] {
+			continue
+		}
+
+		if m, ok := monthNames[token]; ok && month == 0 {
+			month = m
+			continue
+		}
+
+		digits := strings.TrimRight(token, "stndrhe")
+		if token != digits && !slices.Contains([]string{"st", "nd", "rd", "th", "er"}, token[len(digits):]) {
+			return nil, false
+		}
+
+		n, err := strconv.Atoi(digits)
+		if err != nil {
+			return nil, false
+		}
+
+		switch {
+		case len(digits) == 4 && year == 0 && token == digits:
+			year = n
+		case len(digits) <= 2 && day == 0:
+			day = n
+		default:
+			return nil, false
+		}
+	}
+
+	if year == 0 || month == 0 || day == 0 {
+		return nil, false
+	}
+
+	return newDateValue(year, int(month), day, 1)
+}
+
+// newDateValue returns a date value if the year, month and day form a valid date.
+func newDateValue(year, month, day int, confidence float64) (*TypedValue, bool) {
+	if month < 1 || month > 12 {
+		return nil, false
+	}
+
+	t := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+	if t.Day() != day || int(t.Month()) != month {
+		return nil, false
+	}
+
+	return &TypedValue{valueType: TypedValueTypeDate, confidence: confidence, date: t}, true
+}
+
+// expandYear expands a two digit year to the years 1970 to 2069.
+func expandYear(year int) int {
+	if year < 70 {
+		return 2000 + year
+	}
+
+	return 1900 + year
+}
+
+// percentageSuffixes are the suffixes marking a number as percentage.
+var percentageSuffixes = []string{"%", "％", "percent", "pct"}
+
+// parsePercentage parses numbers followed by a percent sign, e.g. 12.5% or 12,5 %.
+func parsePercentage(s string, decimalComma bool) (*TypedValue, bool) {
+	for _, suffix := range percentageSuffixes {
+		if len(s) <= len(suffix) || !strings.EqualFold(s[len(s)-len(suffix):], suffix) {
+			continue
+		}
+
+		n, c, ok := parseNumber(strings.TrimSpace(s[:len(s)-len(suffix)]), decimalComma)
+		if !ok {
+			return nil, false
+		}
+
+		return &TypedValue{valueType: TypedValueTypePercentage, confidence: c, number: n}, true
+	}
+
+	return nil, false
+}
+
+// currencySymbols maps currency symbols and codes to ISO 4217 codes together with the confidence of
+// the mapping. Longer symbols come first so that prefixes like $ do not shadow them.
+var currencySymbols = []struct {
+	symbol     string
+	code       string
+	confidence float64
+}{
+	{"US$", "USD", 1}, {"CA$", "CAD", 1}, {"AU$", "AUD", 1}, {"A$", "AUD", 1}, {"R$", "BRL", 1},
+	{"USD", "USD", 1}, {"EUR", "EUR", 1}, {"GBP", "GBP", 1}, {"JPY", "JPY", 1}, {"CNY", "CNY", 1},
+	{"CHF", "CHF", 1}, {"CAD", "CAD", 1}, {"AUD", "AUD", 1}, {"INR", "INR", 1}, {"BRL", "BRL", 1},
+	{"SEK", "SEK", 1}, {"NOK", "NOK", 1}, {"DKK", "DKK", 1}, {"PLN", "PLN", 1}, {"MXN", "MXN", 1},
+	{"€", "EUR", 1}, {"£", "GBP", 1}, {"₹", "INR", 1}, {"₩", "KRW", 1}, {"Fr.", "CHF", 1},
+	{"¥", "JPY", 0.8}, {"$", "USD", 0.8},
+}
+
+// parseMoney parses amounts with a leading or trailing currency symbol or code, e.g. $1,234.56,
+// -€12 or 12,50 EUR. Negative amounts may be written in parentheses.
+func parseMoney(s string, decimalComma bool, defaultCurrency string, requireCurrency bool) (*TypedValue, bool) {
+	negative := false
+
+	if strings.HasPrefix(s, "(") && strings.HasSuffix(s, ")") {
+		negative, s = true, strings.TrimSpace(s[1:len(s)-1])
+	}
+
+	if strings.HasPrefix(s, "-") {
+		negative, s = !negative, strings.TrimSpace(s[1:])
+	}
+
+	currency, confidence := "", 1.0
+
+	for _, cs := range currencySymbols {
+		if strings.HasPrefix(s, cs.symbol) {
+			s, currency, confidence = strings.TrimSpace(s[len(cs.symbol):]), cs.code, cs.confidence
+			break
+		}
+
+		if strings.HasSuffix(s, cs.symbol) {
+			s, currency, confidence = strings.TrimSpace(s[:len(s)-len(cs.symbol)]), cs.code, cs.confidence
+			break
+		}
+	}
+
+	if currency == "" {
+		if requireCurrency {
+			return nil, false
+		}
+
+		currency, confidence = defaultCurrency, 0.6
+	}
+
+	n, c, ok := parseNumber(s, decimalComma)
+	if !ok {
+		return nil, false
+	}
+
+	if negative {
+		n = -n
+	}
+
+	return &TypedValue{valueType: TypedValueTypeMoney, confidence: confidence * c, number: n, currency: currency}, true
+}
+
+// parseNumber parses numbers with optional sign and thousands separators, e.g. -1,234.56, 1.234,56
+// or 1'234. Numbers with a single separator followed by three digits are ambiguous and resolved by
+// decimalComma with a lower confidence. Spaces and apostrophes are only accepted as thousands
+// separators. It returns the number and the parse confidence.
+func parseNumber(s string, decimalComma bool) (float64, float64, bool) {
+	negative := false
+
+	switch {
+	case strings.HasPrefix(s, "(") && strings.HasSuffix(s, ")"):
+		negative, s = true, s[1:len(s)-1]
+	case strings.HasPrefix(s, "-"), strings.HasPrefix(s, "+"):
+		negative, s = s[0] == '-', s[1:]
+	}
+
+	if strings.ContainsAny(s, " \u00a0\u202f'’") {
+		groups := strings.Split(strings.Map(func(r rune) rune {
+			if strings.ContainsRune(" \u00a0\u202f'’", r) {
+				return ' '
+			}
+
+			return r
+		}, s), " ")
+
+		// Separators must be surrounded by digit groups, e.g. not "'" or "5 '"
+		if len(groups[0]) == 0 || len(groups[0]) > 3 {
+			return 0, 0, false
+		}
+
+		for _, g := range groups[1:] {
+			if len(g) < 3 || strings.ContainsAny(g[:3], ".,") || (len(g) > 3 && g[3] != '.' && g[3] != ',') {
+				return 0, 0, false
+			}
+		}
+
+		s = strings.Join(groups, "")
+	}
+
+	if s == "" || s[len(s)-1] < '0' || s[len(s)-1] > '9' {
+		return 0, 0, false
+	}
+
+	for _, r := range s {
+		if (r < '0' || r > '9') && r != '.' && r != ',' {
+			return 0, 0, false
+		}
+	}
+
+	var (
+		confidence         = 1.0
+		dots, commas       = strings.Count(s, "."), strings.Count(s, ",")
+		decimal, thousands string
+		lastDot, lastComma = strings.LastIndex(s, "."), strings.LastIndex(s, ",")
+		separator, lastSep = ".", lastDot
+	)
+
+	if commas > 0 && lastComma > lastDot {
+		separator, lastSep = ",", lastComma
+	}
+
+	switch {
+	case dots > 0 && commas > 0:
+		decimal, thousands = separator, "."
+		if separator == "." {
+			thousands = ","
+		}
+
+		if strings.Count(s, decimal) > 1 {
+			return 0, 0, false
+		}
+	case dots+commas == 0:
+	case dots > 1:
+		thousands = "."
+	case commas > 1:
+		thousands = ","
+	case len(s)-lastSep-1 == 3 && lastSep > 0 && lastSep <= 3:
+		confidence = 0.8
+
+		if (separator == ",") == decimalComma {
+			decimal = separator
+		} else {
+			thousands = separator
+		}
+	default:
+		decimal = separator
+	}
+
+	intPart, fracPart := s, ""
+	if decimal != "" {
+		intPart, fracPart, _ = strings.Cut(s, decimal)
+	}
+
+	if thousands != "" {
+		groups := strings.Split(intPart, thousands)
+		if len(groups[0]) == 0 || len(groups[0]) > 3 {
+			return 0, 0, false
+		}
+
+		for _, g := range groups[1:] {
+			if len(g) != 3 {
+				return 0, 0, false
+			}
+		}
+
+		intPart = strings.Join(groups, "")
+	}
+
+	if intPart == "" {
+		intPart = "0"
+	}
+
+	if fracPart != "" {
+		intPart += "." + fracPart
+	}
+
+	n, err := strconv.ParseFloat(intPart, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+
+	if negative {
+		n = -n
+	}
+
+	return n, confidence, true
+}
+
+// parsePhoneNumber parses phone numbers of 7 to 15 digits with optional leading + and the
+// separators space, hyphen, period and parentheses.
+func parsePhoneNumber(s string) (*TypedValue, bool) {
+	// Date-shaped texts, e.g. the invalid date 2024-02-30, are no phone numbers
+	if numericDatePattern.MatchString(s) {
+		return nil, false
+	}
+
+	var (
+		digits     strings.Builder
+		plus       bool
+		separators int
+	)
+
+	for i, r := range s {
+		switch {
+		case r >= '0' && r <= '9':
+			digits.WriteRune(r)
+		case r == '+' && i == 0:
+			plus = true
+		case strings.ContainsRune(" -.()", r):
+			separators++
+		default:
+			return nil, false
+		}
+	}
+
+	if digits.Len() < 7 || digits.Len() > 15 {
+		return nil, false
+	}
+
+	confidence := 0.9
+
+	switch {
+	case plus:
+		confidence = 1
+	case separators == 0:
+		confidence = 0.7
+	}
+
+	phoneNumber := digits.String()
+	if plus {
+		phoneNumber = "+" + phoneNumber
+	}
+
+	return &TypedValue{valueType: TypedValueTypePhoneNumber, confidence: confidence, phoneNumber: phoneNumber}, true
+}
@@ -0,0 +1,248 @@
+package textractor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/textract/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeText(t *testing.T) {
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	t.Run("Date", func(t *testing.T) {
+		tests := []struct {
+			text       string
+			dayFirst   bool
+			expected   time.Time
+			confidence float64
+		}{
+			{"2024-01-31", false, date(2024, time.January, 31), 1},
+			{"31.01.2024", false, date(2024, time.January, 31), 1},
+			{"01/31/2024", false, date(2024, time.January, 31), 1},
+			{"31/01/2024", false, date(2024, time.January, 31), 1},
+			{"03/04/2024", false, date(2024, time.March, 4), 0.7},
+			{"03/04/2024", true, date(2024, time.April, 3), 0.7},
+			{"04/04/24", false, date(2024, time.April, 4), 0.9},
+			{"January 31, 2024", false, date(2024, time.January, 31), 1},
+			{"Wed, 31st Jan 2024", false, date(2024, time.January, 31), 1},
+			{"31. März 2024", false, date(2024, time.March, 31), 1},
+			{"1er février 2024", false, date(2024, time.February, 1), 1},
+			{"15 de agosto de 2024", false, date(2024, time.August, 15), 1},
+			{"15-Aug-2024", false, date(2024, time.August, 15), 1},
+		}
+
+		for _, tt := range tests {
+			tv := NormalizeText(tt.text, func(o *NormalizeOptions) {
+				o.DayFirst = tt.dayFirst
+			})
+
+			assert.Equal(t, TypedValueTypeDate, tv.Type(), tt.text)
+			assert.Equal(t, tt.expected, tv.Date(), tt.text)
+			assert.InDelta(t, tt.confidence, tv.Confidence(), 1e-9, tt.text)
+		}
+
+		assert.Equal(t, TypedValueTypeText, NormalizeText("31/02/2024").Type())
+		assert.Equal(t, TypedValueTypeText, NormalizeText("January 2024").Type())
+	})
+
+	t.Run("Money", func(t *testing.T) {
+		tests := []struct {
+			text     string
+			amount   float64
+			currency string
+		}{
+			{"$1,234.56", 1234.56, "USD"},
+			{"US$ 1,234.56", 1234.56, "USD"},
+			{"1.234,56 €", 1234.56, "EUR"},
+			{"EUR 12", 12, "EUR"},
+			{"-£12.50", -12.5, "GBP"},
+			{"($12.50)", -12.5, "USD"},
+			{"CHF 1'234.50", 1234.5, "CHF"},
+		}
+
+		for _, tt := range tests {
+			tv := NormalizeText(tt.text)
+
+			assert.Equal(t, TypedValueTypeMoney, tv.Type(), tt.text)
+			assert.InDelta(t, tt.amount, tv.Number(), 1e-9, tt.text)
+			assert.Equal(t, tt.currency, tv.Currency(), tt.text)
+		}
+
+		assert.Equal(t, "1234.56 USD", NormalizeText("$1,234.56").String())
+		assert.Less(t, NormalizeText("$12").Confidence(), NormalizeText("USD 12").Confidence())
+
+		tv := NormalizeText("12.50", func(o *NormalizeOptions) {
+			o.Types = []TypedValueType{TypedValueTypeMoney}
+			o.DefaultCurrency = "EUR"
+		})
+		assert.Equal(t, TypedValueTypeMoney, tv.Type())
+		assert.Equal(t, "EUR", tv.Currency())
+		assert.InDelta(t, 0.6, tv.Confidence(), 1e-9)
+	})
+
+	t.Run("Percentage", func(t *testing.T) {
+		tv := NormalizeText("12.5%")
+		assert.Equal(t, TypedValueTypePercentage, tv.Type())
+		assert.Equal(t, 12.5, tv.Number())
+		assert.Equal(t, "12.5%", tv.String())
+
+		tv = NormalizeText("12,5 %")
+		assert.Equal(t, TypedValueTypePercentage, tv.Type())
+		assert.Equal(t, 12.5, tv.Number())
+	})
+
+	t.Run("Number", func(t *testing.T) {
+		tests := []struct {
+			text         string
+			decimalComma bool
+			expected     float64
+			confidence   float64
+		}{
+			{"42", false, 42, 1},
+			{"-3.75", false, -3.75, 1},
+			{"1,234,567.89", false, 1234567.89, 1},
+			{"1.234.567,89", false, 1234567.89, 1},
+			{"1 234 567", false, 1234567, 1},
+			{"12,5", false, 12.5, 1},
+			{"1,234", false, 1234, 0.8},
+			{"1,234", true, 1.234, 0.8},
+			{"1.234", false, 1.234, 0.8},
+			{"1.234", true, 1234, 0.8},
+		}
+
+		for _, tt := range tests {
+			tv := NormalizeText(tt.text, func(o *NormalizeOptions) {
+				o.DecimalComma = tt.decimalComma
+			})
+
+			assert.Equal(t, TypedValueTypeNumber, tv.Type(), tt.text)
+			assert.InDelta(t, tt.expected, tv.Number(), 1e-9, tt.text)
+			assert.InDelta(t, tt.confidence, tv.Confidence(), 1e-9, tt.text)
+		}
+
+		assert.Equal(t, TypedValueTypeText, NormalizeText("1,23,4").Type())
+	})
+
+	t.Run("PhoneNumber", func(t *testing.T) {
+		tests := []struct {
+			text     string
+			expected string
+		}{
+			{"555-0100", "5550100"},
+			{"(555) 123-4567", "5551234567"},
+			{"+49 30 1234567", "+49301234567"},
+			{"+1 555 0100", "+15550100"},
+		}
+
+		for _, tt := range tests {
+			tv := NormalizeText(tt.text)
+
+			assert.Equal(t, TypedValueTypePhoneNumber, tv.Type(), tt.text)
+			assert.Equal(t, tt.expected, tv.PhoneNumber(), tt.text)
+		}
+
+		assert.Equal(t, TypedValueTypeText, NormalizeText("555-01").Type())
+	})
+
+	t.Run("Checkbox", func(t *testing.T) {
+		tv := NormalizeText("[X]")
+		assert.Equal(t, TypedValueTypeCheckbox, tv.Type())
+		assert.True(t, tv.IsChecked())
+
+		tv = NormalizeText("☐")
+		assert.Equal(t, TypedValueTypeCheckbox, tv.Type())
+		assert.False(t, tv.IsChecked())
+	})
+
+	t.Run("Text", func(t *testing.T) {
+		tv := NormalizeText("Jane Doe")
+		assert.Equal(t, TypedValueTypeText, tv.Type())
+		assert.Equal(t, "Jane Doe", tv.Text())
+		assert.Equal(t, "Jane Doe", tv.String())
+		assert.Equal(t, 0.0, tv.Confidence())
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		tests := []string{
+			"'",
+			"’",
+			"' '",
+			"5 '",
+			"' 5",
+			"1 000 '",
+			"2024-02-30",
+			"2024-13-01",
+			"31.02.2024",
+		}
+
+		for _, text := range tests {
+			assert.NotPanics(t, func() {
+				assert.Equal(t, TypedValueTypeText, NormalizeText(text).Type(), text)
+			}, text)
+		}
+	})
+
+	t.Run("Types", func(t *testing.T) {
+		tv := NormalizeText("2024", func(o *NormalizeOptions) {
+			o.Types = []TypedValueType{TypedValueTypeDate}
+		})
+		assert.Equal(t, TypedValueTypeText, tv.Type())
+	})
+}
+
+func TestNormalize(t *testing.T) {
+	t.Run("Value", func(t *testing.T) {
+		v := &Value{words: []*Word{{text: "$1,234.56"}}}
+
+		tv := v.Normalize()
+		assert.Equal(t, TypedValueTypeMoney, tv.Type())
+		assert.Equal(t, "$1,234.56", tv.Text())
+
+		v = &Value{selectionElement: &SelectionElement{base: base{confidence: 95}, status: types.SelectionStatusSelected}}
+
+		tv = v.Normalize()
+		assert.Equal(t, TypedValueTypeCheckbox, tv.Type())
+		assert.True(t, tv.IsChecked())
+		assert.InDelta(t, 0.95, tv.Confidence(), 1e-9)
+	})
+
+	t.Run("TableCell", func(t *testing.T) {
+		tc := &TableCell{words: []*Word{{text: "12.5"}, {text: "%"}}}
+
+		tv := tc.Normalize()
+		assert.Equal(t, TypedValueTypePercentage, tv.Type())
+		assert.Equal(t, 12.5, tv.Number())
+
+		tc = &TableCell{selectionElement: &SelectionElement{base: base{confidence: 80}, status: types.SelectionStatusNotSelected}}
+
+		tv = tc.Normalize()
+		assert.Equal(t, TypedValueTypeCheckbox, tv.Type())
+		assert.False(t, tv.IsChecked())
+	})
+
+	t.Run("QueryResult", func(t *testing.T) {
+		qr := &QueryResult{text: "March 4, 2024"}
+
+		tv := qr.Normalize()
+		assert.Equal(t, TypedValueTypeDate, tv.Type())
+		assert.Equal(t, time.Date(2024, time.March, 4, 0, 0, 0, 0, time.UTC), tv.Date())
+	})
+
+	t.Run("Document", func(t *testing.T) {
+		res, err := loadDocumentAPIOutputTestdata("testdata/test-response.json")
+		assert.NoError(t, err)
+
+		doc, err := ParseDocumentAPIOutput(res)
+		assert.NoError(t, err)
+
+		kv := doc.Pages()[0].SearchKeys("Phone Number")[0].Element()
+
+		tv := kv.Value().Normalize()
+		assert.Equal(t, TypedValueTypePhoneNumber, tv.Type())
+		assert.Equal(t, "5550100", tv.PhoneNumber())
+	})
+}
@@ -1,5 +1,7 @@
 package textractor
 
+import "fmt"
+
 // OnLinerizedPageNumber is a callback function to customize the processing of page numbers during linearization.
 type OnLinerizedPageNumber func(pn string) string
 
@@ -151,6 +153,24 @@ type TextLinearizationOptions struct {
 
 	// SignatureToken is the signature representation in the linearized text.
 	SignatureToken string
+
+	// ReadingOrder sets the order in which the layouts of a page are linearized.
+	ReadingOrder ReadingOrder
+
+	// ShowQueries appends the top answers of the queries as "alias: answer" lines to the page text.
+	ShowQueries bool
+
+	// QueryLayoutPrefix is the prefix for the block of query answers.
+	QueryLayoutPrefix string
+
+	// QueryLayoutSuffix is the suffix for the block of query answers.
+	QueryLayoutSuffix string
+
+	// QueryPrefix is the prefix for query answers.
+	QueryPrefix string
+
+	// QuerySuffix is the suffix for query answers.
+	QuerySuffix string
 }
 
 var DefaultLinerizationOptions = TextLinearizationOptions{
@@ -201,4 +221,104 @@ var DefaultLinerizationOptions = TextLinearizationOptions{
 	HeuristicHTolerance:            0.3,
 	HeuristicOverlapRatio:          0.5,
 	SignatureToken:                 "[SIGNATURE]",
+	ReadingOrder:                   ReadingOrderTopToBottom,
+	ShowQueries:                    false,
+	QueryLayoutPrefix:              "\n\n",
+	QueryLayoutSuffix:              "",
+	QueryPrefix:                    "",
+	QuerySuffix:                    "",
+}
+
+// MarkdownLinerizationOptions are the linearization options used by the Markdown exporter.
+var MarkdownLinerizationOptions = TextLinearizationOptions{
+	MaxNumberOfConsecutiveNewLines: 2,
+	HideHeaderLayout:               false,
+	HideFooterLayout:               false,
+	HideFigureLayout:               false,
+	HidePageNumberLayout:           false,
+	PageNumberPrefix:               "",
+	PageNumberSuffix:               "",
+	OnLinerizedPageNumber:          func(pn string) string { return pn },
+	SameParagraphSeparator:         " ",
+	LayoutElementSeparator:         "\n\n",
+	ListElementSeparator:           "\n",
+	ListLayoutPrefix:               "",
+	ListLayoutSuffix:               "",
+	ListElementPrefix:              "- ",
+	ListElementSuffix:              "",
+	RemoveNewLinesInListElements:   true,
+	TitlePrefix:                    "# ",
+	TitleSuffix:                    "",
+	OnLinerizedTitle:               func(t string) string { return t },
+	TableLayoutPrefix:              "",
+	TableLayoutSuffix:              "",
+	TableLinearizationFormat:       "markdown",
+	TableMinTableWords:             0,
+	TableColumnSeparator:           "\t",
+	TablePrefix:                    "",
+	TableSuffix:                    "",
+	TableRowSeparator:              "\n",
+	TableRowPrefix:                 "",
+	TableRowSuffix:                 "",
+	TableCellPrefix:                "",
+	TableCellSuffix:                "",
+	SectionHeaderPrefix:            "## ",
+	SectionHeaderSuffix:            "",
+	OnLinerizedSectionHeader:       func(sh string) string { return sh },
+	KeyValueLayoutPrefix:           "",
+	KeyValueLayoutSuffix:           "",
+	KeyValuePrefix:                 "",
+	KeyValueSuffix:                 "",
+	KeyPrefix:                      "",
+	KeySuffix:                      "",
+	ValuePrefix:                    "",
+	ValueSuffix:                    "",
+	SelectionElementSelected:       "[x]",
+	SelectionElementNotSelected:    "[ ]",
+	HeuristicHTolerance:            0.3,
+	HeuristicOverlapRatio:          0.5,
+	SignatureToken:                 "[SIGNATURE]",
+	ReadingOrder:                   ReadingOrderTopToBottom,
+	ShowQueries:                    false,
+	QueryLayoutPrefix:              "",
+	QueryLayoutSuffix:              "",
+	QueryPrefix:                    "- ",
+	QuerySuffix:                    "",
+}
+
+// Validate checks that the options contain a supported table linearization format and reading order.
+func (o *TextLinearizationOptions) Validate() error {
+	switch o.TableLinearizationFormat {
+	case "plaintext", "markdown":
+	default:
+		return fmt.Errorf("unknown table format: %s", o.TableLinearizationFormat)
+	}
+
+	switch o.ReadingOrder {
+	case "", ReadingOrderTopToBottom, ReadingOrderNative, ReadingOrderColumns:
+	default:
+		return fmt.Errorf("unknown reading order: %s", o.ReadingOrder)
+	}
+
+	return nil
+}
+
+// ParseOptions defines how a Textract response is parsed.
+type ParseOptions struct {
+	// Strict makes parsing fail if any problem is found in the response. By default, invalid blocks
+	// are skipped and the problems are recorded in the ParseReport of the document.
+	Strict bool
+
+	// CorrectOrientation rotates the coordinates of every page into an upright frame before layouts
+	// are grouped, so that rotated or skewed scans are linearized in reading order. The orientation
+	// is estimated from the word polygons of the page. The geometry of the raw blocks is corrected as well.
+	CorrectOrientation bool
+
+	// PolygonOverlap assigns key-values and tables to the layouts whose polygons they overlap. By default,
+	// their bounding boxes are compared, which may also match neighbouring layouts of skewed text.
+	PolygonOverlap bool
+
+	// Concurrency is the maximum number of pages parsed in parallel. Values below two parse the pages
+	// sequentially. The parsed document is identical in both cases.
+	Concurrency int
 }
@@ -0,0 +1,37 @@
+package textractor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTextLinearizationOptions(t *testing.T) {
+	t.Run("Validate", func(t *testing.T) {
+		assert.NoError(t, DefaultLinerizationOptions.Validate())
+		assert.NoError(t, MarkdownLinerizationOptions.Validate())
+
+		opts := DefaultLinerizationOptions
+		opts.TableLinearizationFormat = "xml"
+		assert.EqualError(t, opts.Validate(), "unknown table format: xml")
+
+		opts = DefaultLinerizationOptions
+		opts.ReadingOrder = "DIAGONAL"
+		assert.EqualError(t, opts.Validate(), "unknown reading order: DIAGONAL")
+	})
+
+	t.Run("UnknownTableFormat", func(t *testing.T) {
+		res, err := loadDocumentAPIOutputTestdata("testdata/test-document.json")
+		assert.NoError(t, err)
+
+		doc, err := ParseDocumentAPIOutput(res)
+		assert.NoError(t, err)
+
+		table := doc.Tables()[0]
+
+		// Unknown formats fall back to plaintext instead of panicking
+		assert.Equal(t, table.Text(), table.Text(func(tlo *TextLinearizationOptions) {
+			tlo.TableLinearizationFormat = "xml"
+		}))
+	})
+}
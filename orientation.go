@@ -0,0 +1,127 @@
+package textractor
+
+import (
+	"math"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/textract/types"
+)
+
+// Orientation estimates the orientation of the page from the polygons of its words. The baselines
+// of all words are summed up, so longer words have a larger influence. It returns nil if no word has a polygon.
+func (p *Page) Orientation() *Orientation {
+	polygons := make([]Polygon, len(p.words))
+	for i, w := range p.words {
+		polygons[i] = w.Polygon()
+	}
+
+	return estimateOrientation(polygons)
+}
+
+// estimateOrientation returns the orientation of the sum of the first edges of the polygons, or nil
+// if none of the polygons has an edge.
+func estimateOrientation(polygons []Polygon) *Orientation {
+	var dx, dy float64
+
+	for _, p := range polygons {
+		if len(p) < 2 {
+			continue
+		}
+
+		dx += p[1].X() - p[0].X()
+		dy += p[1].Y() - p[0].Y()
+	}
+
+	if dx == 0 && dy == 0 {
+		return nil
+	}
+
+	return &Orientation{point0: &Point{x: 0, y: 0}, point1: &Point{x: dx, y: dy}}
+}
+
+// correctOrientation rotates the geometry of all blocks of a page around the page center, so that the
+// text of the page is upright. The orientation of each page is estimated from its words. Rotations are
+// applied in normalized page coordinates, as the page size is unknown. Multiples of 90 degrees map the
+// page onto the normalized frame of the upright page. Other angles are only exact for square pages and
+// shear the geometry of other pages by their aspect ratio, which is negligible for the small skew of scans.
+func (bp *blockParser) correctOrientation() {
+	pagePolygons := make(map[int32][]Polygon)
+
+	for _, id := range bp.blockTypeIDs(types.BlockTypeWord) {
+		b := bp.blockByID(id)
+		pagePolygons[aws.ToInt32(b.Page)] = append(pagePolygons[aws.ToInt32(b.Page)], newPolygon(b.Geometry.Polygon))
+	}
+
+	angles := make(map[int32]float64, len(pagePolygons))
+
+	for page, polygons := range pagePolygons {
+		if o := estimateOrientation(polygons); o != nil {
+			angles[page] = o.Radians()
+		}
+	}
+
+	for id, b := range bp.idBlockMap {
+		angle, ok := angles[aws.ToInt32(b.Page)]
+		if !ok || angle == 0 || b.Geometry == nil || b.BlockType == types.BlockTypePage {
+			continue
+		}
+
+		b.Geometry = rotateGeometry(b.Geometry, -angle)
+		bp.idBlockMap[id] = b
+	}
+}
+
+// rotateGeometry returns a copy of the geometry rotated by the angle in radians around the page center.
+// The bounding box is replaced by the bounding box of the rotated polygon, or of the rotated corners
+// of the bounding box if the geometry has no polygon.
+func rotateGeometry(g *types.Geometry, angle float64) *types.Geometry {
+	sin, cos := math.Sincos(angle)
+
+	rotate := func(x, y float32) types.Point {
+		dx, dy := float64(x)-0.5, float64(y)-0.5
+
+		return types.Point{
+			X: float32(0.5 + dx*cos - dy*sin),
+			Y: float32(0.5 + dx*sin + dy*cos),
+		}
+	}
+
+	rotated := &types.Geometry{
+		Polygon: make([]types.Point, len(g.Polygon)),
+	}
+
+	for i, p := range g.Polygon {
+		rotated.Polygon[i] = rotate(p.X, p.Y)
+	}
+
+	corners := rotated.Polygon
+	if len(corners) == 0 && g.BoundingBox != nil {
+		bb := g.BoundingBox
+
+		corners = []types.Point{
+			rotate(bb.Left, bb.Top),
+			rotate(bb.Left+bb.Width, bb.Top),
+			rotate(bb.Left+bb.Width, bb.Top+bb.Height),
+			rotate(bb.Left, bb.Top+bb.Height),
+		}
+	}
+
+	if len(corners) > 0 {
+		left, top := corners[0].X, corners[0].Y
+		right, bottom := left, top
+
+		for _, c := range corners[1:] {
+			left, top = min(left, c.X), min(top, c.Y)
+			right, bottom = max(right, c.X), max(bottom, c.Y)
+		}
+
+		rotated.BoundingBox = &types.BoundingBox{
+			Left:   left,
+			Top:    top,
+			Width:  right - left,
+			Height: bottom - top,
+		}
+	}
+
+	return rotated
+}
@@ -0,0 +1,52 @@
+package textractor
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCorrectOrientation(t *testing.T) {
+	res, err := loadDocumentAPIOutputTestdata("testdata/test-response.json")
+	assert.NoError(t, err)
+
+	doc, err := ParseDocumentAPIOutput(res)
+	assert.NoError(t, err)
+
+	assert.InDelta(t, 0, doc.Pages()[0].Orientation().Degrees(), 0.5)
+
+	for _, degrees := range []float64{90, 180, 270, 4, -6} {
+		rotated := &DocumentAPIOutput{DocumentMetadata: res.DocumentMetadata}
+
+		for _, b := range res.Blocks {
+			if b.Geometry != nil && b.BlockType != "PAGE" {
+				b.Geometry = rotateGeometry(b.Geometry, degrees*math.Pi/180)
+			}
+
+			rotated.Blocks = append(rotated.Blocks, b)
+		}
+
+		rotatedDoc, err := ParseDocumentAPIOutput(rotated)
+		assert.NoError(t, err)
+
+		expected := degrees
+		if expected > 180 {
+			expected -= 360
+		}
+
+		assert.InDelta(t, expected, rotatedDoc.Pages()[0].Orientation().Degrees(), 0.5)
+
+		if degrees == 90 {
+			assert.NotEqual(t, doc.Text(), rotatedDoc.Text())
+		}
+
+		correctedDoc, err := ParseDocumentAPIOutput(rotated, func(o *ParseOptions) {
+			o.CorrectOrientation = true
+		})
+		assert.NoError(t, err)
+
+		assert.InDelta(t, 0, correctedDoc.Pages()[0].Orientation().Degrees(), 0.5)
+		assert.Equal(t, doc.Text(), correctedDoc.Text(), "rotated by %v degrees", degrees)
+	}
+}
@@ -0,0 +1,381 @@
+package textractor
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"math"
+	"slices"
+	"strings"
+)
+
+// OverlayOptions defines how the elements of a page are drawn onto the page image.
+type OverlayOptions struct {
+	// Elements are the types of elements to draw. Defaults to all types.
+	Elements []OverlayElement
+
+	// Colors overrides the default color of an element type.
+	Colors map[OverlayElement]color.Color
+
+	// StrokeWidth is the width of the outlines in pixels.
+	StrokeWidth int
+
+	// Polygons draws the polygons of the elements instead of their bounding boxes.
+	Polygons bool
+
+	// Labels draws a label with the type of each element above its outline.
+	Labels bool
+
+	// LabelScale is the factor by which the 5x7 pixel label font is scaled.
+	LabelScale int
+}
+
+// DefaultOverlayColors are the default colors of the element types drawn by the overlay renderer.
+var DefaultOverlayColors = map[OverlayElement]color.Color{
+	OverlayElementWord:      color.RGBA{R: 0x1f, G: 0x77, B: 0xb4, A: 0xff},
+	OverlayElementLine:      color.RGBA{R: 0x2c, G: 0xa0, B: 0x2c, A: 0xff},
+	OverlayElementLayout:    color.RGBA{R: 0xff, G: 0x7f, B: 0x0e, A: 0xff},
+	OverlayElementTable:     color.RGBA{R: 0xd6, G: 0x27, B: 0x28, A: 0xff},
+	OverlayElementCell:      color.RGBA{R: 0x94, G: 0x67, B: 0xbd, A: 0xff},
+	OverlayElementKeyValue:  color.RGBA{R: 0x17, G: 0xbe, B: 0xcf, A: 0xff},
+	OverlayElementSignature: color.RGBA{R: 0xe3, G: 0x77, B: 0xc2, A: 0xff},
+}
+
+// overlayShape is an outline drawn by the overlay renderer.
+type overlayShape struct {
+	element OverlayElement
+	label   string
+	base    *base
+}
+
+// RenderOverlay decodes a PNG or JPEG page image from src, draws the elements of the page onto it
+// and encodes the result to dst in the format of the source image.
+func (p *Page) RenderOverlay(dst io.Writer, src io.Reader, optFns ...func(*OverlayOptions)) error {
+	img, format, err := image.Decode(src)
+	if err != nil {
+		return fmt.Errorf("failed to decode page image: %w", err)
+	}
+
+	overlay := p.DrawOverlay(img, optFns...)
+
+	switch format {
+	case "png":
+		err = png.Encode(dst, overlay)
+	case "jpeg":
+		err = jpeg.Encode(dst, overlay, &jpeg.Options{Quality: 95})
+	default:
+		return fmt.Errorf("unsupported image format: %s", format)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to encode overlay image: %w", err)
+	}
+
+	return nil
+}
+
+// DrawOverlay returns a copy of the page image with the bounding boxes or polygons of the page elements
+// drawn onto it. Larger elements are drawn first so that smaller elements remain visible.
+func (p *Page) DrawOverlay(img image.Image, optFns ...func(*OverlayOptions)) *image.RGBA {
+	opts := OverlayOptions{
+		Elements: []OverlayElement{
+			OverlayElementLayout, OverlayElementTable, OverlayElementCell, OverlayElementKeyValue,
+			OverlayElementLine, OverlayElementWord, OverlayElementSignature,
+		},
+		StrokeWidth: 2,
+		LabelScale:  1,
+	}
+
+	for _, fn := range optFns {
+		fn(&opts)
+	}
+
+	colors := make(map[OverlayElement]color.Color, len(DefaultOverlayColors))
+	for e, c := range DefaultOverlayColors {
+		colors[e] = c
+	}
+
+	for e, c := range opts.Colors {
+		colors[e] = c
+	}
+
+	canvas := image.NewRGBA(img.Bounds())
+	draw.Draw(canvas, canvas.Bounds(), img, img.Bounds().Min, draw.Src)
+
+	r := &overlayRenderer{canvas: canvas, opts: opts}
+
+	for _, s := range p.overlayShapes(opts.Elements) {
+		c, ok := colors[s.element]
+		if !ok {
+			continue
+		}
+
+		r.drawShape(s, c)
+	}
+
+	return canvas
+}
+
+// overlayShapes returns the shapes of the requested element types in drawing order.
+func (p *Page) overlayShapes(elements []OverlayElement) []overlayShape {
+	var shapes []overlayShape
+
+	if slices.Contains(elements, OverlayElementLayout) {
+		var addLayouts func(layouts []*Layout)
+
+		// Nested layouts, e.g. the texts of a list, are drawn after their parent
+		addLayouts = func(layouts []*Layout) {
+			for _, l := range layouts {
+				shapes = append(shapes, overlayShape{
+					element: OverlayElementLayout,
+					label:   strings.TrimPrefix(string(l.BlockType()), "LAYOUT_"),
+					base:    &l.base,
+				})
+
+				for _, c := range l.children {
+					if child, ok := c.(*Layout); ok {
+						addLayouts([]*Layout{child})
+					}
+				}
+			}
+		}
+
+		addLayouts(p.layouts)
+	}
+
+	for _, t := range p.tables {
+		if slices.Contains(elements, OverlayElementTable) {
+			shapes = append(shapes, overlayShape{element: OverlayElementTable, label: "TABLE", base: &t.base})
+		}
+
+		if slices.Contains(elements, OverlayElementCell) {
+			for _, c := range t.cells {
+				shapes = append(shapes, overlayShape{
+					element: OverlayElementCell,
+					label:   fmt.Sprintf("%d,%d", c.rowIndex, c.columnIndex),
+					base:    &c.base,
+				})
+			}
+		}
+	}
+
+	if slices.Contains(elements, OverlayElementKeyValue) {
+		for _, kv := range p.keyValues {
+			if kv.Key() != nil {
+				shapes = append(shapes, overlayShape{element: OverlayElementKeyValue, label: "KEY", base: &kv.Key().base})
+			}
+
+			if kv.Value() != nil {
+				shapes = append(shapes, overlayShape{element: OverlayElementKeyValue, label: "VALUE", base: &kv.Value().base})
+			}
+		}
+	}
+
+	if slices.Contains(elements, OverlayElementLine) {
+		for _, l := range p.lines {
+			shapes = append(shapes, overlayShape{element: OverlayElementLine, label: "LINE", base: &l.base})
+		}
+	}
+
+	if slices.Contains(elements, OverlayElementWord) {
+		for _, w := range p.words {
+			shapes = append(shapes, overlayShape{element: OverlayElementWord, label: "WORD", base: &w.base})
+		}
+	}
+
+	if slices.Contains(elements, OverlayElementSignature) {
+		for _, s := range p.signatures {
+			shapes = append(shapes, overlayShape{element: OverlayElementSignature, label: "SIGNATURE", base: &s.base})
+		}
+	}
+
+	return shapes
+}
+
+// overlayRenderer draws outlines and labels onto a canvas.
+type overlayRenderer struct {
+	canvas *image.RGBA
+	opts   OverlayOptions
+}
+
+// drawShape draws the outline and the optional label of a shape.
+func (r *overlayRenderer) drawShape(s overlayShape, c color.Color) {
+	bb := s.base.BoundingBox()
+	if bb == nil {
+		return
+	}
+
+	if r.opts.Polygons && len(s.base.polygon) > 1 {
+		points := make([]image.Point, len(s.base.polygon))
+		for i, p := range s.base.polygon {
+			points[i] = r.toPixel(p.X(), p.Y())
+		}
+
+		for i := range points {
+			r.drawLine(points[i], points[(i+1)%len(points)], c)
+		}
+	} else {
+		rect := image.Rectangle{Min: r.toPixel(bb.Left(), bb.Top()), Max: r.toPixel(bb.Right(), bb.Bottom())}
+		r.drawRect(rect, c)
+	}
+
+	if r.opts.Labels && s.label != "" {
+		r.drawLabel(r.toPixel(bb.Left(), bb.Top()), s.label, c)
+	}
+}
+
+// toPixel converts normalized page coordinates to a pixel position on the canvas.
+func (r *overlayRenderer) toPixel(x, y float64) image.Point {
+	b := r.canvas.Bounds()
+
+	return image.Point{
+		X: b.Min.X + int(math.Round(x*float64(b.Dx()))),
+		Y: b.Min.Y + int(math.Round(y*float64(b.Dy()))),
+	}
+}
+
+// drawRect draws the outline of a rectangle.
+func (r *overlayRenderer) drawRect(rect image.Rectangle, c color.Color) {
+	w := max(r.opts.StrokeWidth, 1)
+	src := image.NewUniform(c)
+
+	for _, edge := range []image.Rectangle{
+		image.Rect(rect.Min.X, rect.Min.Y, rect.Max.X+w, rect.Min.Y+w),
+		image.Rect(rect.Min.X, rect.Max.Y, rect.Max.X+w, rect.Max.Y+w),
+		image.Rect(rect.Min.X, rect.Min.Y, rect.Min.X+w, rect.Max.Y+w),
+		image.Rect(rect.Max.X, rect.Min.Y, rect.Max.X+w, rect.Max.Y+w),
+	} {
+		draw.Draw(r.canvas, edge.Intersect(r.canvas.Bounds()), src, image.Point{}, draw.Over)
+	}
+}
+
+// drawLine draws a line with the stroke width using Bresenham's algorithm.
+func (r *overlayRenderer) drawLine(from, to image.Point, c color.Color) {
+	w := max(r.opts.StrokeWidth, 1)
+	src := image.NewUniform(c)
+
+	step := func(d int) int {
+		if d < 0 {
+			return -1
+		}
+
+		return 1
+	}
+
+	dx, dy := int(math.Abs(float64(to.X-from.X))), -int(math.Abs(float64(to.Y-from.Y)))
+	sx, sy := step(to.X-from.X), step(to.Y-from.Y)
+	e := dx + dy
+	p := from
+
+	for {
+		brush := image.Rect(p.X, p.Y, p.X+w, p.Y+w).Intersect(r.canvas.Bounds())
+		draw.Draw(r.canvas, brush, src, image.Point{}, draw.Over)
+
+		if p == to {
+			return
+		}
+
+		if e2 := 2 * e; e2 >= dy {
+			e += dy
+			p.X += sx
+		}
+
+		if e2 := 2 * e; e2 <= dx {
+			e += dx
+			p.Y += sy
+		}
+	}
+}
+
+// drawLabel draws the text in white on a box of the given color. The label is placed above
+// the anchor, or below it if there is no room at the top of the canvas.
+func (r *overlayRenderer) drawLabel(anchor image.Point, text string, c color.Color) {
+	scale := max(r.opts.LabelScale, 1)
+	padding := scale
+	text = strings.ToUpper(text)
+
+	width := len(text)*(glyphWidth+1)*scale - scale + 2*padding
+	height := glyphHeight*scale + 2*padding
+
+	box := image.Rect(anchor.X, anchor.Y-height, anchor.X+width, anchor.Y)
+	if box.Min.Y < r.canvas.Bounds().Min.Y {
+		box = box.Add(image.Point{Y: height})
+	}
+
+	draw.Draw(r.canvas, box.Intersect(r.canvas.Bounds()), image.NewUniform(c), image.Point{}, draw.Src)
+
+	x := box.Min.X + padding
+
+	for _, ch := range text {
+		glyph, ok := labelFont[ch]
+		if ok {
+			for gy, row := range glyph {
+				for gx, px := range row {
+					if px != '#' {
+						continue
+					}
+
+					dot := image.Rect(x+gx*scale, box.Min.Y+padding+gy*scale, x+(gx+1)*scale, box.Min.Y+padding+(gy+1)*scale)
+					draw.Draw(r.canvas, dot.Intersect(r.canvas.Bounds()), image.White, image.Point{}, draw.Src)
+				}
+			}
+		}
+
+		x += (glyphWidth + 1) * scale
+	}
+}
+
+const (
+	glyphWidth  = 5
+	glyphHeight = 7
+)
+
+// labelFont is a minimal 5x7 pixel font for the upper case labels of the overlay renderer.
+// Characters without a glyph are rendered as blanks.
+var labelFont = map[rune][glyphHeight]string{
+	'A': {".###.", "#...#", "#...#", "#####", "#...#", "#...#", "#...#"},
+	'B': {"####.", "#...#", "#...#", "####.", "#...#", "#...#", "####."},
+	'C': {".###.", "#...#", "#....", "#....", "#....", "#...#", ".###."},
+	'D': {"####.", "#...#", "#...#", "#...#", "#...#", "#...#", "####."},
+	'E': {"#####", "#....", "#....", "####.", "#....", "#....", "#####"},
+	'F': {"#####", "#....", "#....", "####.", "#....", "#....", "#...."},
+	'G': {".###.", "#...#", "#....", "#.###", "#...#", "#...#", ".###."},
+	'H': {"#...#", "#...#", "#...#", "#####", "#...#", "#...#", "#...#"},
+	'I': {".###.", "..#..", "..#..", "..#..", "..#..", "..#..", ".###."},
+	'J': {"..###", "...#.", "...#.", "...#.", "...#.", "#..#.", ".##.."},
+	'K': {"#...#", "#..#.", "#.#..", "##...", "#.#..", "#..#.", "#...#"},
+	'L': {"#....", "#....", "#....", "#....", "#....", "#....", "#####"},
+	'M': {"#...#", "##.##", "#.#.#", "#.#.#", "#...#", "#...#", "#...#"},
+	'N': {"#...#", "#...#", "##..#", "#.#.#", "#..##", "#...#", "#...#"},
+	'O': {".###.", "#...#", "#...#", "#...#", "#...#", "#...#", ".###."},
+	'P': {"####.", "#...#", "#...#", "####.", "#....", "#....", "#...."},
+	'Q': {".###.", "#...#", "#...#", "#...#", "#.#.#", "#..#.", ".##.#"},
+	'R': {"####.", "#...#", "#...#", "####.", "#.#..", "#..#.", "#...#"},
+	'S': {".####", "#....", "#....", ".###.", "....#", "....#", "####."},
+	'T': {"#####", "..#..", "..#..", "..#..", "..#..", "..#..", "..#.."},
+	'U': {"#...#", "#...#", "#...#", "#...#", "#...#", "#...#", ".###."},
+	'V': {"#...#", "#...#", "#...#", "#...#", "#...#", ".#.#.", "..#.."},
+	'W': {"#...#", "#...#", "#...#", "#.#.#", "#.#.#", "#.#.#", ".#.#."},
+	'X': {"#...#", "#...#", ".#.#.", "..#..", ".#.#.", "#...#", "#...#"},
+	'Y': {"#...#", "#...#", ".#.#.", "..#..", "..#..", "..#..", "..#.."},
+	'Z': {"#####", "....#", "...#.", "..#..", ".#...", "#....", "#####"},
+	'0': {".###.", "#...#", "#..##", "#.#.#", "##..#", "#...#", ".###."},
+	'1': {"..#..", ".##..", "..#..", "..#..", "..#..", "..#..", ".###."},
+	'2': {".###.", "#...#", "....#", "...#.", "..#..", ".#...", "#####"},
+	'3': {"#####", "...#.", "..#..", "...#.", "....#", "#...#", ".###."},
+	'4': {"...#.", "..##.", ".#.#.", "#..#.", "#####", "...#.", "...#."},
+	'5': {"#####", "#....", "####.", "....#", "....#", "#...#", ".###."},
+	'6': {"..##.", ".#...", "#....", "####.", "#...#", "#...#", ".###."},
+	'7': {"#####", "....#", "...#.", "..#..", ".#...", ".#...", ".#..."},
+	'8': {".###.", "#...#", "#...#", ".###.", "#...#", "#...#", ".###."},
+	'9': {".###.", "#...#", "#...#", ".####", "....#", "...#.", ".##.."},
+	'_': {".....", ".....", ".....", ".....", ".....", ".....", "#####"},
+	'-': {".....", ".....", ".....", "#####", ".....", ".....", "....."},
+	'.': {".....", ".....", ".....", ".....", ".....", ".##..", ".##.."},
+	',': {".....", ".....", ".....", ".....", ".##..", "..#..", ".#..."},
+	':': {".....", ".##..", ".##..", ".....", ".##..", ".##..", "....."},
+}
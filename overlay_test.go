@@ -0,0 +1,136 @@
+package textractor
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/gif"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOverlay(t *testing.T) {
+	newTestImage := func() *image.RGBA {
+		img := image.NewRGBA(image.Rect(0, 0, 200, 100))
+		draw.Draw(img, img.Bounds(), image.White, image.Point{}, draw.Src)
+
+		return img
+	}
+
+	page := &Page{}
+	word := &Word{
+		base: base{
+			id:          "word",
+			boundingBox: &BoundingBox{left: 0.25, top: 0.5, width: 0.5, height: 0.2},
+			polygon:     Polygon{{x: 0.25, y: 0.5}, {x: 0.75, y: 0.5}, {x: 0.75, y: 0.7}, {x: 0.25, y: 0.7}},
+			page:        page,
+		},
+		text: "word",
+	}
+	page.words = []*Word{word}
+
+	wordColor := DefaultOverlayColors[OverlayElementWord]
+
+	t.Run("BoundingBoxes", func(t *testing.T) {
+		img := newTestImage()
+		overlay := page.DrawOverlay(img)
+
+		assert.Equal(t, img.Bounds(), overlay.Bounds())
+		assert.Equal(t, toRGBA(wordColor), overlay.At(50, 50))
+		assert.Equal(t, toRGBA(wordColor), overlay.At(100, 70))
+		assert.Equal(t, toRGBA(wordColor), overlay.At(150, 60))
+		assert.Equal(t, color.RGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}, overlay.At(100, 60))
+
+		// The source image is not modified
+		assert.Equal(t, color.RGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}, img.At(50, 50))
+	})
+
+	t.Run("Polygons", func(t *testing.T) {
+		overlay := page.DrawOverlay(newTestImage(), func(o *OverlayOptions) {
+			o.Polygons = true
+			o.StrokeWidth = 1
+		})
+
+		assert.Equal(t, toRGBA(wordColor), overlay.At(100, 50))
+		assert.Equal(t, toRGBA(wordColor), overlay.At(150, 60))
+		assert.Equal(t, color.RGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}, overlay.At(100, 60))
+	})
+
+	t.Run("ElementsAndColors", func(t *testing.T) {
+		red := color.RGBA{R: 0xff, A: 0xff}
+
+		overlay := page.DrawOverlay(newTestImage(), func(o *OverlayOptions) {
+			o.Colors = map[OverlayElement]color.Color{OverlayElementWord: red}
+		})
+		assert.Equal(t, red, overlay.At(50, 50))
+
+		overlay = page.DrawOverlay(newTestImage(), func(o *OverlayOptions) {
+			o.Elements = []OverlayElement{OverlayElementLine}
+		})
+		assert.Equal(t, color.RGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}, overlay.At(50, 50))
+	})
+
+	t.Run("NestedLayouts", func(t *testing.T) {
+		item := &Layout{base: base{id: "item", boundingBox: &BoundingBox{left: 0.1, top: 0.2, width: 0.3, height: 0.2}}}
+		list := &Layout{
+			base:     base{id: "list", boundingBox: &BoundingBox{left: 0.05, top: 0.1, width: 0.9, height: 0.8}},
+			children: []LayoutChild{item},
+		}
+
+		overlay := (&Page{layouts: []*Layout{list}}).DrawOverlay(newTestImage())
+
+		layoutColor := toRGBA(DefaultOverlayColors[OverlayElementLayout])
+		assert.Equal(t, layoutColor, overlay.At(10, 50))
+		assert.Equal(t, layoutColor, overlay.At(20, 30), "Nested layouts should be drawn")
+	})
+
+	t.Run("Labels", func(t *testing.T) {
+		overlay := page.DrawOverlay(newTestImage(), func(o *OverlayOptions) {
+			o.Labels = true
+		})
+
+		// The label box is drawn above the top left corner of the word
+		assert.Equal(t, toRGBA(wordColor), overlay.At(50, 42))
+		// The first column of the "W" glyph is white
+		assert.Equal(t, color.RGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}, overlay.At(51, 42))
+	})
+
+	t.Run("RenderOverlay", func(t *testing.T) {
+		res, err := loadDocumentAPIOutputTestdata("testdata/test-document.json")
+		assert.NoError(t, err)
+
+		doc, err := ParseDocumentAPIOutput(res)
+		assert.NoError(t, err)
+
+		src := &bytes.Buffer{}
+		assert.NoError(t, png.Encode(src, newTestImage()))
+
+		dst := &bytes.Buffer{}
+		assert.NoError(t, doc.Pages()[0].RenderOverlay(dst, src, func(o *OverlayOptions) {
+			o.Labels = true
+		}))
+
+		img, format, err := image.Decode(dst)
+		assert.NoError(t, err)
+		assert.Equal(t, "png", format)
+		assert.Equal(t, image.Rect(0, 0, 200, 100), img.Bounds())
+	})
+
+	t.Run("UnsupportedFormat", func(t *testing.T) {
+		src := &bytes.Buffer{}
+		assert.NoError(t, gif.Encode(src, newTestImage(), nil))
+
+		err := page.RenderOverlay(&bytes.Buffer{}, src)
+		assert.Error(t, err)
+
+		err = page.RenderOverlay(&bytes.Buffer{}, bytes.NewBufferString("no image"))
+		assert.ErrorContains(t, err, "failed to decode page image")
+	})
+}
+
+func toRGBA(c color.Color) color.RGBA {
+	return color.RGBAModel.Convert(c).(color.RGBA)
+}
@@ -1,8 +1,6 @@
 package textractor
 
 import (
-	"slices"
-	"sort"
 	"strings"
 )
 
@@ -19,6 +17,7 @@ type Page struct {
 	layouts    []*Layout
 	queries    []*Query
 	signatures []*Signature
+	dimensions *PageDimensions
 }
 
 func (p *Page) ID() string {
@@ -29,10 +28,12 @@ func (p *Page) Number() int {
 	return p.number
 }
 
+// Width returns the normalized width of the page, which is usually 1. See Dimensions for the real size.
 func (p *Page) Width() float64 {
 	return p.width
 }
 
+// Height returns the normalized height of the page, which is usually 1. See Dimensions for the real size.
 func (p *Page) Height() float64 {
 	return p.height
 }
@@ -61,6 +62,17 @@ func (p *Page) Queries() []*Query {
 	return p.queries
 }
 
+// QueryByAlias returns the first query of the page with the given alias, or nil if there is none.
+func (p *Page) QueryByAlias(alias string) *Query {
+	for _, q := range p.queries {
+		if q.alias == alias {
+			return q
+		}
+	}
+
+	return nil
+}
+
 func (p *Page) Signatures() []*Signature {
 	return p.signatures
 }
@@ -70,14 +82,13 @@ func (p *Page) AddLayouts(layouts ...*Layout) {
 }
 
 func (p *Page) Text(optFns ...func(*TextLinearizationOptions)) string {
-	// Create a copy of the layouts to avoid modifying the original slice
-	sortedLayouts := make([]*Layout, len(p.layouts))
-	copy(sortedLayouts, p.layouts)
+	opts := DefaultLinerizationOptions
 
-	// Sort layouts based on the reading order
-	sort.Slice(sortedLayouts, func(i, j int) bool {
-		return sortedLayouts[i].BoundingBox().Top() < sortedLayouts[j].BoundingBox().Top()
-	})
+	for _, fn := range optFns {
+		fn(&opts)
+	}
+
+	sortedLayouts := p.sortedLayouts(opts.ReadingOrder)
 
 	pageTexts := make([]string, len(sortedLayouts))
 
@@ -87,6 +98,12 @@ func (p *Page) Text(optFns ...func(*TextLinearizationOptions)) string {
 		pageTexts[i] = text
 	}
 
+	if opts.ShowQueries {
+		if text := queriesText(p.queries, opts); text != "" {
+			pageTexts = append(pageTexts, opts.QueryLayoutPrefix+text+opts.QueryLayoutSuffix)
+		}
+	}
+
 	return strings.Join(pageTexts, "\n")
 }
 
@@ -106,6 +123,7 @@ func (p *Page) SearchValueByKey(key string) []*KeyValue {
 	return result
 }
 
-func (p *Page) isChild(id string) bool {
-	return slices.Contains(p.childIDs, id)
+// sortedLayouts returns a copy of the page layouts sorted by the given reading order.
+func (p *Page) sortedLayouts(order ReadingOrder) []*Layout {
+	return orderLayouts(p.layouts, order)
 }
@@ -11,33 +11,58 @@ import (
 
 const threshold = 0.8
 
+// idNamespace is the namespace of the IDs of elements that are not backed by a Textract block.
+var idNamespace = uuid.NewSHA1(uuid.NameSpaceURL, []byte("https://github.com/hupe1980/go-textractor"))
+
+// derivedID returns a name-based UUID for an element of the given type synthesized from the
+// element with the source ID, so that parsing the same response always yields the same IDs.
+func derivedID(blockType types.BlockType, sourceID string) string {
+	return uuid.NewSHA1(idNamespace, []byte(string(blockType)+"/"+sourceID)).String()
+}
+
 type pageParser struct {
 	bp        *blockParser
 	page      *Page
+	report    *ParseReport
 	typeIDMap map[types.BlockType][]string
+	words     []*Word
 	idWordMap map[string]*Word
 	idLineMap map[string]*Line
+
+	// staleLayouts are the layouts whose polygon must be updated after their children changed
+	staleLayouts map[*Layout]struct{}
 }
 
 func newPageParser(bp *blockParser, page *Page) *pageParser {
-	typeIDMap := make(map[types.BlockType][]string)
-	for k, v := range bp.typeIDMap {
-		ids := make([]string, 0)
+	typeIDMap, ok := bp.pageTypeIDMap[page.id]
+	if !ok {
+		childIDs := make(map[string]struct{}, len(page.childIDs))
+		for _, id := range page.childIDs {
+			childIDs[id] = struct{}{}
+		}
+
+		typeIDMap = make(map[types.BlockType][]string)
 
-		for _, id := range v {
-			if page.isChild(id) {
-				ids = append(ids, id)
+		for k, v := range bp.typeIDMap {
+			ids := make([]string, 0)
+
+			for _, id := range v {
+				if _, ok := childIDs[id]; ok {
+					ids = append(ids, id)
+				}
 			}
-		}
 
-		typeIDMap[k] = ids
+			typeIDMap[k] = ids
+		}
 	}
 
 	return &pageParser{
-		bp:        bp,
-		page:      page,
-		typeIDMap: typeIDMap,
-		idWordMap: make(map[string]*Word),
+		bp:           bp,
+		page:         page,
+		report:       &ParseReport{},
+		typeIDMap:    typeIDMap,
+		idWordMap:    make(map[string]*Word),
+		staleLayouts: make(map[*Layout]struct{}),
 	}
 }
 
@@ -46,6 +71,11 @@ func (pp *pageParser) addPageElements() {
 	pp.page.layouts = pp.createLayouts()
 	pp.page.keyValues = pp.createKeyValues()
 	pp.page.tables = pp.createTables()
+
+	for l := range pp.staleLayouts {
+		l.updatePolygon()
+	}
+
 	pp.page.words = pp.createWords()
 	pp.page.queries = pp.createQueries()
 	pp.page.signatures = pp.createSignatures()
@@ -63,18 +93,19 @@ func (pp *pageParser) newWord(b types.Block) *Word {
 	}
 
 	pp.idWordMap[word.id] = word
+	pp.words = append(pp.words, word)
 
 	return word
 }
 
 func (pp *pageParser) createWords() []*Word {
-	words := make([]*Word, 0, len(pp.idWordMap))
+	words := make([]*Word, 0, len(pp.words))
 
-	for _, w := range pp.idWordMap {
+	for _, w := range pp.words {
 		if w.line == nil {
 			line := &Line{
 				base: base{
-					id:          uuid.New().String(),
+					id:          derivedID(types.BlockTypeLine, w.ID()),
 					confidence:  w.Confidence(),
 					blockType:   types.BlockTypeLine,
 					boundingBox: w.BoundingBox(),
@@ -105,11 +136,11 @@ func (pp *pageParser) createLines() []*Line {
 			base: newBase(b, pp.page),
 		}
 
-		rIDs := filterRelationshipIDsByType(b, types.RelationshipTypeChild)
-		words := make([]*Word, 0, len(rIDs))
+		wordBlocks := pp.relatedBlocks(b, types.RelationshipTypeChild)
+		words := make([]*Word, 0, len(wordBlocks))
 
-		for _, rid := range rIDs {
-			word := pp.newWord(pp.bp.blockByID(rid))
+		for _, wb := range wordBlocks {
+			word := pp.newWord(wb)
 			word.line = line
 			words = append(words, word)
 		}
@@ -143,24 +174,35 @@ func (pp *pageParser) createKeyValues() []*KeyValue {
 			continue
 		}
 
+		valueBlocks := pp.relatedBlocks(b, types.RelationshipTypeValue)
+		if len(valueBlocks) == 0 {
+			// Dangling value IDs have already been reported
+			if len(filterRelationshipIDsByType(b, types.RelationshipTypeValue)) == 0 {
+				pp.report.add(&MissingRelationshipError{
+					BlockID:          id,
+					BlockType:        b.BlockType,
+					RelationshipType: types.RelationshipTypeValue,
+				})
+			}
+
+			continue
+		}
+
 		key := &Key{
 			base: newBase(b, pp.page),
 		}
 
-		for _, wid := range filterRelationshipIDsByType(b, types.RelationshipTypeChild) {
-			word := pp.newWord(pp.bp.blockByID(wid))
-			key.words = append(key.words, word)
+		for _, wb := range pp.relatedBlocks(b, types.RelationshipTypeChild) {
+			key.words = append(key.words, pp.newWord(wb))
 		}
 
-		valueIDs := filterRelationshipIDsByType(b, types.RelationshipTypeValue)
-		v := pp.bp.blockByID(valueIDs[0])
+		v := valueBlocks[0]
 
 		value := &Value{
 			base: newBase(v, pp.page),
 		}
 
-		for _, cid := range filterRelationshipIDsByType(v, types.RelationshipTypeChild) {
-			wb := pp.bp.blockByID(cid)
+		for _, wb := range pp.relatedBlocks(v, types.RelationshipTypeChild) {
 			if wb.BlockType == types.BlockTypeWord {
 				value.words = append(value.words, pp.newWord(wb))
 			} else if wb.BlockType == types.BlockTypeSelectionElement {
@@ -185,15 +227,21 @@ func (pp *pageParser) createKeyValues() []*KeyValue {
 		)
 
 		for _, pl := range pp.page.Layouts() {
-			if is := pl.BoundingBox().Intersection(kv.BoundingBox()); is != nil {
+			if pp.overlaps(pl, kv) {
+				grown := false
+
 				if !added && pl.blockType == types.BlockTypeLayoutKeyValue {
 					pl.AddChildren(kv)
 
-					added = true
+					added, grown = true, true
 				}
 
 			wordloop:
 				for _, w := range kv.Words() {
+					if w.line == nil {
+						continue
+					}
+
 					pl.children = slices.DeleteFunc(pl.children, func(lc LayoutChild) bool {
 						return lc.ID() == w.line.ID()
 					})
@@ -206,7 +254,7 @@ func (pp *pageParser) createKeyValues() []*KeyValue {
 				if len(pl.children) == 0 {
 					delIDs = append(delIDs, pl.ID())
 				} else {
-					pl.boundingBox = NewEnclosingBoundingBox(pl.children...)
+					pp.updateLayoutGeometry(pl, grown)
 				}
 			}
 		}
@@ -214,12 +262,12 @@ func (pp *pageParser) createKeyValues() []*KeyValue {
 		if !added {
 			pp.page.layouts = append(pp.page.layouts, &Layout{
 				base: base{
-					id:          uuid.New().String(),
+					id:          derivedID(types.BlockTypeLayoutKeyValue, kv.Key().ID()),
 					confidence:  kv.Confidence(),
 					blockType:   types.BlockTypeLayoutKeyValue,
 					boundingBox: kv.BoundingBox(),
-					//polygon:     kv.Polygon(),
-					page: kv.page,
+					polygon:     kv.Polygon(),
+					page:        kv.page,
 				},
 				children: []LayoutChild{kv},
 			})
@@ -233,12 +281,41 @@ func (pp *pageParser) createKeyValues() []*KeyValue {
 	return keyValues
 }
 
+// updateLayoutGeometry updates the bounding box of a layout after its children changed. The polygon is
+// only recomputed if children were added, as the polygon of the layout still encloses the remaining
+// children otherwise. It is updated right away for polygon overlap, or once all children have been assigned.
+func (pp *pageParser) updateLayoutGeometry(l *Layout, childrenAdded bool) {
+	l.boundingBox = NewEnclosingBoundingBox(l.children...)
+
+	if !childrenAdded {
+		return
+	}
+
+	if pp.bp.polygonOverlap {
+		l.updatePolygon()
+		return
+	}
+
+	pp.staleLayouts[l] = struct{}{}
+}
+
+// overlaps checks if the element overlaps the layout. The polygons are compared if polygon overlap is
+// enabled and both have a polygon, otherwise the bounding boxes.
+func (pp *pageParser) overlaps(l *Layout, e LayoutChild) bool {
+	if pe, ok := e.(PolygonAccessor); ok && pp.bp.polygonOverlap && len(l.Polygon()) > 2 && len(pe.Polygon()) > 2 {
+		return l.Polygon().Intersection(pe.Polygon()) != nil
+	}
+
+	return l.BoundingBox().Intersection(e.BoundingBox()) != nil
+}
+
 func (pp *pageParser) createLayouts() []*Layout {
 	ids := pp.blockTypeIDs(types.BlockType("LAYOUT"))
 	layouts := make([]*Layout, 0, len(ids))
 
 	for _, id := range ids {
 		b := pp.bp.blockByID(id)
+		children := pp.relatedBlocks(b, types.RelationshipTypeChild)
 
 		var layout *Layout
 		switch b.BlockType { // nolint exhaustive
@@ -247,27 +324,19 @@ func (pp *pageParser) createLayouts() []*Layout {
 				base: newBase(b, pp.page),
 			}
 
-			for _, r := range b.Relationships {
-				if r.Type == types.RelationshipTypeChild {
-					for _, ri := range r.Ids {
-						l := pp.bp.blockByID(ri)
-
-						leafLayout := &Layout{
-							base:       newBase(l, pp.page),
-							noNewLines: true,
-						}
-
-						for _, r := range l.Relationships {
-							if r.Type == types.RelationshipTypeChild {
-								for _, ri := range r.Ids {
-									leafLayout.AddChildren(pp.idLineMap[ri])
-								}
-							}
-						}
+			for _, l := range children {
+				leafLayout := &Layout{
+					base:       newBase(l, pp.page),
+					noNewLines: true,
+				}
 
-						layout.AddChildren(leafLayout)
+				for _, c := range pp.relatedBlocks(l, types.RelationshipTypeChild) {
+					if line, ok := pp.idLineMap[aws.ToString(c.Id)]; ok {
+						leafLayout.AddChildren(line)
 					}
 				}
+
+				layout.AddChildren(leafLayout)
 			}
 		case types.BlockTypeLayoutText, types.BlockTypeLayoutSectionHeader, types.BlockTypeLayoutTitle:
 			layout = &Layout{
@@ -281,15 +350,9 @@ func (pp *pageParser) createLayouts() []*Layout {
 			}
 		}
 
-		for _, r := range b.Relationships {
-			if r.Type == types.RelationshipTypeChild {
-				for _, ri := range r.Ids {
-					c := pp.bp.blockByID(ri)
-
-					if c.BlockType == types.BlockTypeLine {
-						layout.children = append(layout.children, pp.idLineMap[ri])
-					}
-				}
+		for _, c := range children {
+			if line, ok := pp.idLineMap[aws.ToString(c.Id)]; ok && c.BlockType == types.BlockTypeLine {
+				layout.children = append(layout.children, line)
 			}
 		}
 
@@ -302,7 +365,7 @@ func (pp *pageParser) createLayouts() []*Layout {
 		for _, line := range pp.page.Lines() {
 			layout := &Layout{
 				base: base{
-					id:          uuid.New().String(),
+					id:          derivedID(types.BlockTypeLayoutText, line.ID()),
 					confidence:  line.Confidence(),
 					blockType:   types.BlockTypeLayoutText,
 					boundingBox: line.BoundingBox(),
@@ -334,17 +397,13 @@ func (pp *pageParser) createTables() []*Table {
 
 		idCellMap := make(map[string]*TableCell, 0)
 
-		for _, cid := range filterRelationshipIDsByType(b, types.RelationshipTypeChild) {
-			c := pp.bp.blockByID(cid)
-
+		for _, c := range pp.relatedBlocks(b, types.RelationshipTypeChild) {
 			if c.BlockType == types.BlockTypeCell {
 				cell := &TableCell{
 					cell: newCell(c, pp.page),
 				}
 
-				for _, rid := range filterRelationshipIDsByType(c, types.RelationshipTypeChild) {
-					c := pp.bp.blockByID(rid)
-
+				for _, c := range pp.relatedBlocks(c, types.RelationshipTypeChild) {
 					switch c.BlockType { // nolint exhaustive
 					case types.BlockTypeWord:
 						word := pp.newWord(c)
@@ -364,9 +423,7 @@ func (pp *pageParser) createTables() []*Table {
 			}
 		}
 
-		for _, id := range filterRelationshipIDsByType(b, types.RelationshipTypeMergedCell) {
-			mc := pp.bp.blockByID(id)
-
+		for _, mc := range pp.relatedBlocks(b, types.RelationshipTypeMergedCell) {
 			mergedCell := &TableMergedCell{
 				cell: newCell(mc, pp.page),
 			}
@@ -384,15 +441,12 @@ func (pp *pageParser) createTables() []*Table {
 			table.mergedCells = append(table.mergedCells, mergedCell)
 		}
 
-		for _, id := range filterRelationshipIDsByType(b, types.RelationshipTypeTableTitle) {
-			t := pp.bp.blockByID(id)
-
+		for _, t := range pp.relatedBlocks(b, types.RelationshipTypeTableTitle) {
 			title := &TableTitle{
 				base: newBase(t, pp.page),
 			}
 
-			for _, rid := range filterRelationshipIDsByType(t, types.RelationshipTypeChild) {
-				w := pp.bp.blockByID(rid)
+			for _, w := range pp.relatedBlocks(t, types.RelationshipTypeChild) {
 				if w.BlockType == types.BlockTypeWord {
 					word := pp.newWord(w)
 					title.words = append(title.words, word)
@@ -402,15 +456,12 @@ func (pp *pageParser) createTables() []*Table {
 			table.title = title
 		}
 
-		for _, id := range filterRelationshipIDsByType(b, types.RelationshipTypeTableFooter) {
-			f := pp.bp.blockByID(id)
-
+		for _, f := range pp.relatedBlocks(b, types.RelationshipTypeTableFooter) {
 			footer := &TableFooter{
 				base: newBase(f, pp.page),
 			}
 
-			for _, rid := range filterRelationshipIDsByType(f, types.RelationshipTypeChild) {
-				w := pp.bp.blockByID(rid)
+			for _, w := range pp.relatedBlocks(f, types.RelationshipTypeChild) {
 				if w.BlockType == types.BlockTypeWord {
 					footer.words = append(footer.words, pp.newWord(w))
 				}
@@ -427,15 +478,21 @@ func (pp *pageParser) createTables() []*Table {
 		)
 
 		for _, pl := range pp.page.Layouts() {
-			if is := pl.BoundingBox().Intersection(table.BoundingBox()); is != nil {
+			if pp.overlaps(pl, table) {
+				grown := false
+
 				if !added && pl.blockType == types.BlockTypeLayoutTable {
 					pl.AddChildren(table)
 
-					added = true
+					added, grown = true, true
 				}
 
 			wordloop:
 				for _, w := range table.Words() {
+					if w.line == nil {
+						continue
+					}
+
 					pl.children = slices.DeleteFunc(pl.children, func(lc LayoutChild) bool {
 						return lc.ID() == w.line.ID()
 					})
@@ -448,7 +505,7 @@ func (pp *pageParser) createTables() []*Table {
 				if len(pl.children) == 0 {
 					delIDs = append(delIDs, pl.ID())
 				} else {
-					pl.boundingBox = NewEnclosingBoundingBox(pl.children...)
+					pp.updateLayoutGeometry(pl, grown)
 				}
 			}
 		}
@@ -456,7 +513,7 @@ func (pp *pageParser) createTables() []*Table {
 		if !added {
 			pp.page.layouts = append(pp.page.layouts, &Layout{
 				base: base{
-					id:          uuid.New().String(),
+					id:          derivedID(types.BlockTypeLayoutTable, table.ID()),
 					confidence:  table.Confidence(),
 					blockType:   types.BlockTypeLayoutTable,
 					boundingBox: table.BoundingBox(),
@@ -479,19 +536,41 @@ func (pp *pageParser) createQueries() []*Query {
 	ids := pp.blockTypeIDs(types.BlockTypeQuery)
 	queries := make([]*Query, 0, len(ids))
 
+	var idx *SpatialIndex[*Word]
+
 	for _, id := range ids {
 		b := pp.bp.blockByID(id)
 
-		rIDs := filterRelationshipIDsByType(b, types.RelationshipTypeAnswer)
+		answerBlocks := pp.relatedBlocks(b, types.RelationshipTypeAnswer)
 
-		results := make([]*QueryResult, len(rIDs))
+		results := make([]*QueryResult, len(answerBlocks))
 
-		for i, id := range rIDs {
-			rb := pp.bp.blockByID(id)
+		for i, rb := range answerBlocks {
 			results[i] = &QueryResult{
 				base: newBase(rb, pp.page),
 				text: aws.ToString(rb.Text),
 			}
+
+			if results[i].boundingBox == nil {
+				continue
+			}
+
+			if idx == nil {
+				idx = NewSpatialIndex(pp.page.words)
+			}
+
+			var words []*Word
+
+			// Answers have no child relationships, so the words are linked by the area they cover
+			for _, w := range idx.Intersecting(results[i].boundingBox) {
+				if is := w.BoundingBox().Intersection(results[i].boundingBox); is != nil && is.Area() >= 0.5*w.BoundingBox().Area() {
+					words = append(words, w)
+				}
+			}
+
+			if len(words) > 0 {
+				results[i].words = sortWordsInReadingOrder(words)
+			}
 		}
 
 		queries = append(queries, &Query{
@@ -512,7 +591,8 @@ func (pp *pageParser) createSignatures() []*Signature {
 	ids := pp.blockTypeIDs(types.BlockTypeSignature)
 	signatures := make([]*Signature, 0, len(ids))
 
-	layouts := pp.page.Layouts()
+	// Sort a copy, so that the layouts of the page keep the order of the response
+	layouts := slices.Clone(pp.page.Layouts())
 	sort.Slice(layouts, func(i, j int) bool {
 		return layouts[i].BoundingBox().Top() < layouts[j].BoundingBox().Top()
 	})
@@ -539,6 +619,12 @@ func (pp *pageParser) createSignatures() []*Signature {
 	return signatures
 }
 
+// relatedBlocks returns the blocks referenced by the relationships of the given type. Dangling
+// references are recorded in the report of the page.
+func (pp *pageParser) relatedBlocks(b types.Block, relationshipType types.RelationshipType) []types.Block {
+	return pp.bp.relatedBlocks(b, relationshipType, pp.report)
+}
+
 func (pp *pageParser) blockTypeIDs(blockType types.BlockType) []string {
 	return pp.typeIDMap[blockType]
 }
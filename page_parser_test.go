@@ -42,4 +42,66 @@ func TestPageParser(t *testing.T) {
 		assert.Equal(t, "id1", resultSignatures[0].id)
 		assert.Equal(t, "id2", resultSignatures[1].id)
 	})
+
+	t.Run("overlaps", func(t *testing.T) {
+		// A skewed layout whose bounding box, but not its polygon, overlaps the key-value pair
+		layout := &Layout{
+			base: base{
+				boundingBox: &BoundingBox{left: 0, top: 0, width: 0.5, height: 0.5},
+				polygon:     Polygon{{x: 0, y: 0}, {x: 0.1, y: 0}, {x: 0.5, y: 0.4}, {x: 0.5, y: 0.5}},
+			},
+		}
+
+		kv := &KeyValue{
+			key: &Key{
+				base: base{
+					boundingBox: &BoundingBox{left: 0.05, top: 0.3, width: 0.1, height: 0.1},
+					polygon:     Polygon{{x: 0.05, y: 0.3}, {x: 0.15, y: 0.3}, {x: 0.15, y: 0.4}, {x: 0.05, y: 0.4}},
+				},
+			},
+		}
+
+		pp := newPageParser(newBlockParser(nil), &Page{})
+		assert.True(t, pp.overlaps(layout, kv))
+
+		pp.bp.polygonOverlap = true
+		assert.False(t, pp.overlaps(layout, kv))
+
+		layout.polygon = nil
+		assert.True(t, pp.overlaps(layout, kv))
+	})
+
+	t.Run("updateLayoutGeometry", func(t *testing.T) {
+		polygon := Polygon{{x: 0, y: 0}, {x: 0.5, y: 0}, {x: 0.5, y: 0.5}, {x: 0, y: 0.5}}
+		line := &Line{
+			base: base{
+				boundingBox: &BoundingBox{left: 0.1, top: 0.1, width: 0.2, height: 0.1},
+				polygon:     Polygon{{x: 0.1, y: 0.1}, {x: 0.3, y: 0.1}, {x: 0.3, y: 0.2}, {x: 0.1, y: 0.2}},
+			},
+		}
+
+		for _, polygonOverlap := range []bool{false, true} {
+			pp := newPageParser(newBlockParser(nil), &Page{})
+			pp.bp.polygonOverlap = polygonOverlap
+
+			layout := &Layout{base: base{polygon: polygon}, children: []LayoutChild{line}}
+
+			pp.updateLayoutGeometry(layout, false)
+			assert.InDelta(t, line.BoundingBox().Width(), layout.BoundingBox().Width(), 1e-9)
+
+			for l := range pp.staleLayouts {
+				l.updatePolygon()
+			}
+
+			assert.Equal(t, polygon, layout.Polygon(), "Polygon should be kept if no children were added")
+
+			pp.updateLayoutGeometry(layout, true)
+
+			for l := range pp.staleLayouts {
+				l.updatePolygon()
+			}
+
+			assert.Equal(t, line.Polygon(), layout.Polygon())
+		}
+	})
 }
@@ -1,7 +1,9 @@
 package textractor
 
 import (
+	"slices"
 	"sort"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/service/textract/types"
 )
@@ -18,6 +20,11 @@ type Query struct {
 	raw        types.Block    // Raw block data
 }
 
+// ID returns the identifier of the query.
+func (q *Query) ID() string {
+	return q.id
+}
+
 // Text returns the text associated with the query.
 func (q *Query) Text() string {
 	return q.text
@@ -28,6 +35,22 @@ func (q *Query) Alias() string {
 	return q.alias
 }
 
+// Pages returns the pages the query was applied to as given in the request, e.g. "1-3" or "*".
+func (q *Query) Pages() []string {
+	return q.queryPages
+}
+
+// Page returns the page of the query. For queries merged across pages, it is the first page.
+func (q *Query) Page() *Page {
+	return q.page
+}
+
+// Results returns the results of the query.
+func (q *Query) Results() []*QueryResult {
+	return q.results
+}
+
+// HasResult checks if the query has at least one result.
 func (q *Query) HasResult() bool {
 	return len(q.results) > 0
 }
@@ -53,13 +76,61 @@ func (q *Query) ResultsByConfidence() []*QueryResult {
 	return sortedResults
 }
 
+// linearizedText returns the top result as "alias: answer", or an empty string if the query has no result.
+// The query text is used if the query has no alias.
+func (q *Query) linearizedText(opts TextLinearizationOptions) string {
+	r := q.TopResult()
+	if r == nil {
+		return ""
+	}
+
+	label := q.alias
+	if label == "" {
+		label = q.text
+	}
+
+	return opts.QueryPrefix + label + ": " + r.text + opts.QuerySuffix
+}
+
 // QueryResult represents the result of a parsed query.
 type QueryResult struct {
 	base
-	text string
+	text  string
+	words []*Word
 }
 
 // Text returns the extracted text from the query result.
 func (qr *QueryResult) Text() string {
 	return qr.text
 }
+
+// Words returns the words of the page covered by the answer, in reading order.
+func (qr *QueryResult) Words() []*Word {
+	return qr.words
+}
+
+// Lines returns the lines containing the words covered by the answer.
+func (qr *QueryResult) Lines() []*Line {
+	var lines []*Line
+
+	for _, w := range qr.words {
+		if w.line != nil && !slices.Contains(lines, w.line) {
+			lines = append(lines, w.line)
+		}
+	}
+
+	return lines
+}
+
+// queriesText returns the linearized answers of the queries, one per line.
+func queriesText(queries []*Query, opts TextLinearizationOptions) string {
+	texts := make([]string, 0, len(queries))
+
+	for _, q := range queries {
+		if t := q.linearizedText(opts); t != "" {
+			texts = append(texts, t)
+		}
+	}
+
+	return strings.Join(texts, "\n")
+}
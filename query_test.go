@@ -1,8 +1,12 @@
 package textractor
 
 import (
+	"encoding/json"
+	"strings"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/textract/types"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -78,3 +82,135 @@ func TestQueryResult(t *testing.T) {
 		assert.Equal(t, "TestText", qr.Text(), "Text method does not return expected value")
 	})
 }
+
+func TestQueryIntegration(t *testing.T) {
+	doc, err := ParseDocumentAPIOutput(newQueryTestDocumentAPIOutput())
+	assert.NoError(t, err)
+	assert.False(t, doc.ParseReport().HasWarnings())
+
+	page := doc.Pages()[0]
+
+	t.Run("Words", func(t *testing.T) {
+		q := page.QueryByAlias("TOTAL")
+		assert.NotNil(t, q)
+		assert.Equal(t, []string{"1-2"}, q.Pages())
+		assert.Equal(t, page, q.Page())
+
+		r := q.TopResult()
+		assert.Len(t, r.Words(), 2)
+		assert.Equal(t, "$1,234.56", r.Words()[0].Text())
+		assert.Equal(t, "USD", r.Words()[1].Text())
+		assert.Len(t, r.Lines(), 1)
+		assert.Equal(t, "Total: $1,234.56 USD", r.Lines()[0].Text())
+
+		assert.Nil(t, page.QueryByAlias("DUE"))
+	})
+
+	t.Run("Text", func(t *testing.T) {
+		assert.NotContains(t, page.Text(), "TOTAL")
+
+		text := page.Text(func(tlo *TextLinearizationOptions) {
+			tlo.ShowQueries = true
+		})
+		assert.True(t, strings.HasSuffix(text, "\n\nTOTAL: $1,234.56 USD\nWho signed?: Jane Doe"), text)
+
+		markdown := page.Markdown(func(tlo *TextLinearizationOptions) {
+			tlo.ShowQueries = true
+		})
+		assert.True(t, strings.HasSuffix(markdown, "\n\n- TOTAL: $1,234.56 USD\n- Who signed?: Jane Doe"), markdown)
+	})
+
+	t.Run("Document", func(t *testing.T) {
+		queries := doc.Queries()
+		assert.Len(t, queries, 2)
+
+		q := doc.QueryByAlias("TOTAL")
+		assert.Equal(t, []string{"1-2"}, q.Pages())
+		assert.Len(t, q.Results(), 2)
+		assert.Equal(t, 1, q.Results()[0].PageNumber())
+		assert.Equal(t, 2, q.Results()[1].PageNumber())
+		assert.Equal(t, "$99.00", q.TopResult().Text())
+
+		// The page queries are left unchanged
+		assert.Len(t, page.QueryByAlias("TOTAL").Results(), 1)
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		data, err := json.Marshal(doc)
+		assert.NoError(t, err)
+
+		restored := new(Document)
+		assert.NoError(t, json.Unmarshal(data, restored))
+
+		r := restored.Pages()[0].QueryByAlias("TOTAL").TopResult()
+		assert.Equal(t, "$1,234.56", r.Words()[0].Text())
+		assert.Same(t, restored.Pages()[0].Lines()[0], r.Lines()[0])
+	})
+}
+
+// newQueryTestDocumentAPIOutput returns a two page response. The first page contains the line
+// "Total: $1,234.56 USD" and the answers to two queries, the second page another answer to the first query.
+func newQueryTestDocumentAPIOutput() *DocumentAPIOutput {
+	geometry := func(left, top, width, height float32) *types.Geometry {
+		return &types.Geometry{BoundingBox: &types.BoundingBox{Left: left, Top: top, Width: width, Height: height}}
+	}
+
+	block := func(id string, blockType types.BlockType, page int32, text string, g *types.Geometry, relationships ...types.Relationship) types.Block {
+		b := types.Block{
+			Id:            aws.String(id),
+			BlockType:     blockType,
+			Page:          aws.Int32(page),
+			Geometry:      g,
+			Relationships: relationships,
+			Confidence:    aws.Float32(99),
+		}
+
+		if text != "" {
+			b.Text = aws.String(text)
+		}
+
+		return b
+	}
+
+	children := func(ids ...string) types.Relationship {
+		return types.Relationship{Type: types.RelationshipTypeChild, Ids: ids}
+	}
+
+	answer := func(ids ...string) types.Relationship {
+		return types.Relationship{Type: types.RelationshipTypeAnswer, Ids: ids}
+	}
+
+	query := func(id string, page int32, text, alias, resultID string) types.Block {
+		b := block(id, types.BlockTypeQuery, page, "", nil, answer(resultID))
+		b.Query = &types.Query{Text: aws.String(text), Alias: aws.String(alias), Pages: []string{"1-2"}}
+
+		return b
+	}
+
+	result3 := block("result-3", types.BlockTypeQueryResult, 2, "$99.00", geometry(0.21, 0.095, 0.12, 0.03))
+	result3.Confidence = aws.Float32(100)
+
+	return &DocumentAPIOutput{
+		DocumentMetadata: &types.DocumentMetadata{Pages: aws.Int32(2)},
+		Blocks: []types.Block{
+			block("page-1", types.BlockTypePage, 1, "", geometry(0, 0, 1, 1), children("line-1", "line-2", "query-1", "query-2")),
+			block("line-1", types.BlockTypeLine, 1, "Total: $1,234.56 USD", geometry(0.1, 0.1, 0.4, 0.02), children("word-1", "word-2", "word-3")),
+			block("word-1", types.BlockTypeWord, 1, "Total:", geometry(0.1, 0.1, 0.1, 0.02)),
+			block("word-2", types.BlockTypeWord, 1, "$1,234.56", geometry(0.22, 0.1, 0.15, 0.02)),
+			block("word-3", types.BlockTypeWord, 1, "USD", geometry(0.4, 0.1, 0.1, 0.02)),
+			block("line-2", types.BlockTypeLine, 1, "Jane Doe", geometry(0.1, 0.5, 0.2, 0.02), children("word-4", "word-5")),
+			block("word-4", types.BlockTypeWord, 1, "Jane", geometry(0.1, 0.5, 0.08, 0.02)),
+			block("word-5", types.BlockTypeWord, 1, "Doe", geometry(0.2, 0.5, 0.1, 0.02)),
+			query("query-1", 1, "What is the total?", "TOTAL", "result-1"),
+			block("result-1", types.BlockTypeQueryResult, 1, "$1,234.56 USD", geometry(0.21, 0.095, 0.3, 0.03)),
+			query("query-2", 1, "Who signed?", "", "result-2"),
+			block("result-2", types.BlockTypeQueryResult, 1, "Jane Doe", geometry(0.09, 0.49, 0.22, 0.04)),
+			block("page-2", types.BlockTypePage, 2, "", geometry(0, 0, 1, 1), children("line-3", "query-3")),
+			block("line-3", types.BlockTypeLine, 2, "Total: $99.00", geometry(0.1, 0.1, 0.3, 0.02), children("word-6", "word-7")),
+			block("word-6", types.BlockTypeWord, 2, "Total:", geometry(0.1, 0.1, 0.1, 0.02)),
+			block("word-7", types.BlockTypeWord, 2, "$99.00", geometry(0.22, 0.1, 0.1, 0.02)),
+			query("query-3", 2, "What is the total?", "TOTAL", "result-3"),
+			result3,
+		},
+	}
+}
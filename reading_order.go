@@ -0,0 +1,103 @@
+package textractor
+
+import (
+	"sort"
+)
+
+// xyCutTolerance is the overlap of two bounding boxes that is still treated as a gap by the XY-cut.
+const xyCutTolerance = 0.005
+
+// orderLayouts returns a copy of the layouts in the given reading order.
+func orderLayouts(layouts []*Layout, order ReadingOrder) []*Layout {
+	// Create a copy of the layouts to avoid modifying the original slice
+	ordered := make([]*Layout, len(layouts))
+	copy(ordered, layouts)
+
+	switch order { // nolint exhaustive
+	case ReadingOrderNative:
+		return ordered
+	case ReadingOrderColumns:
+		return xyCut(ordered)
+	default:
+		sort.Slice(ordered, func(i, j int) bool {
+			return ordered[i].BoundingBox().Top() < ordered[j].BoundingBox().Top()
+		})
+
+		return ordered
+	}
+}
+
+// xyCut orders the layouts by recursively splitting them into columns or rows at the widest
+// gap between their bounding boxes. Columns are read left to right, rows top to bottom. If
+// no further split is possible, the remaining layouts are ordered by their top coordinate.
+func xyCut(layouts []*Layout) []*Layout {
+	if len(layouts) <= 1 {
+		return layouts
+	}
+
+	rows, rowGap := splitAtGaps(layouts, (*BoundingBox).Top, (*BoundingBox).Bottom)
+	columns, columnGap := splitAtGaps(layouts, (*BoundingBox).Left, (*BoundingBox).Right)
+
+	var groups [][]*Layout
+
+	switch {
+	case len(columns) > 1 && (len(rows) == 1 || columnGap > rowGap):
+		groups = columns
+	case len(rows) > 1:
+		groups = rows
+	default:
+		sort.SliceStable(layouts, func(i, j int) bool {
+			if layouts[i].BoundingBox().Top() == layouts[j].BoundingBox().Top() {
+				return layouts[i].BoundingBox().Left() < layouts[j].BoundingBox().Left()
+			}
+
+			return layouts[i].BoundingBox().Top() < layouts[j].BoundingBox().Top()
+		})
+
+		return layouts
+	}
+
+	ordered := make([]*Layout, 0, len(layouts))
+
+	for _, g := range groups {
+		ordered = append(ordered, xyCut(g)...)
+	}
+
+	return ordered
+}
+
+// splitAtGaps projects the bounding boxes of the layouts onto one axis and splits them at every gap
+// in the projection. It returns the groups in ascending order and the width of the widest gap.
+func splitAtGaps(layouts []*Layout, start, end func(*BoundingBox) float64) ([][]*Layout, float64) {
+	sorted := make([]*Layout, len(layouts))
+	copy(sorted, layouts)
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return start(sorted[i].BoundingBox()) < start(sorted[j].BoundingBox())
+	})
+
+	var (
+		groups [][]*Layout
+		maxGap float64
+	)
+
+	current := []*Layout{sorted[0]}
+	currentEnd := end(sorted[0].BoundingBox())
+
+	for _, l := range sorted[1:] {
+		bb := l.BoundingBox()
+
+		if gap := start(bb) - currentEnd; gap > -xyCutTolerance {
+			groups = append(groups, current)
+			current = nil
+			maxGap = max(maxGap, gap)
+		}
+
+		current = append(current, l)
+		currentEnd = max(currentEnd, end(bb))
+	}
+
+	groups = append(groups, current)
+
+	return groups, maxGap
+}
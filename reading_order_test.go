@@ -0,0 +1,95 @@
+package textractor
+
+import (
+	"flag"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var updateGolden = flag.Bool("update-golden", false, "update the golden files in testdata")
+
+func TestReadingOrder(t *testing.T) {
+	res, err := loadDocumentAPIOutputTestdata("testdata/test-layout.json")
+	assert.NoError(t, err)
+
+	doc, err := ParseDocumentAPIOutput(res)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		order  ReadingOrder
+		golden string
+	}{
+		{ReadingOrderTopToBottom, "testdata/test-layout-top-to-bottom.golden"},
+		{ReadingOrderColumns, "testdata/test-layout-columns.golden"},
+		{ReadingOrderNative, "testdata/test-layout-native.golden"},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.order), func(t *testing.T) {
+			text := doc.Text(func(tlo *TextLinearizationOptions) {
+				tlo.ReadingOrder = tt.order
+			})
+
+			if *updateGolden {
+				assert.NoError(t, os.WriteFile(tt.golden, []byte(text), 0600))
+			}
+
+			golden, err := os.ReadFile(tt.golden)
+			assert.NoError(t, err)
+			assert.Equal(t, string(golden), text)
+		})
+	}
+
+	t.Run("NativeKeepsResponseOrder", func(t *testing.T) {
+		assert.NotEqual(t, doc.Text(func(tlo *TextLinearizationOptions) {
+			tlo.ReadingOrder = ReadingOrderNative
+		}), doc.Text())
+	})
+
+	t.Run("DefaultIsTopToBottom", func(t *testing.T) {
+		assert.Equal(t, doc.Text(func(tlo *TextLinearizationOptions) {
+			tlo.ReadingOrder = ReadingOrderTopToBottom
+		}), doc.Text())
+	})
+}
+
+func TestXYCut(t *testing.T) {
+	newLayout := func(id string, left, top, width, height float64) *Layout {
+		return &Layout{base: base{id: id, boundingBox: &BoundingBox{left: left, top: top, width: width, height: height}}}
+	}
+
+	ids := func(layouts []*Layout) []string {
+		result := make([]string, len(layouts))
+		for i, l := range layouts {
+			result[i] = l.ID()
+		}
+
+		return result
+	}
+
+	t.Run("TwoColumns", func(t *testing.T) {
+		layouts := []*Layout{
+			newLayout("title", 0.1, 0.05, 0.8, 0.05),
+			newLayout("left-1", 0.1, 0.15, 0.35, 0.1),
+			newLayout("right-1", 0.55, 0.15, 0.35, 0.2),
+			newLayout("left-2", 0.1, 0.3, 0.35, 0.2),
+			newLayout("right-2", 0.55, 0.4, 0.35, 0.1),
+			newLayout("footer", 0.1, 0.9, 0.8, 0.05),
+		}
+
+		assert.Equal(t, []string{"title", "left-1", "left-2", "right-1", "right-2", "footer"}, ids(orderLayouts(layouts, ReadingOrderColumns)))
+		assert.Equal(t, []string{"title", "left-1", "right-1", "left-2", "right-2", "footer"}, ids(orderLayouts(layouts, ReadingOrderTopToBottom)))
+		assert.Equal(t, ids(layouts), ids(orderLayouts(layouts, ReadingOrderNative)))
+	})
+
+	t.Run("Overlapping", func(t *testing.T) {
+		layouts := []*Layout{
+			newLayout("b", 0.3, 0.2, 0.5, 0.2),
+			newLayout("a", 0.1, 0.1, 0.5, 0.2),
+		}
+
+		assert.Equal(t, []string{"a", "b"}, ids(orderLayouts(layouts, ReadingOrderColumns)))
+	})
+}
@@ -0,0 +1,241 @@
+package textractor
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/hupe1980/go-textractor/internal"
+)
+
+// SearchOptions defines how texts are normalized and compared by the fuzzy search.
+type SearchOptions struct {
+	// MinSimilarity is the minimum similarity between 0 and 1 for a text to match the query.
+	MinSimilarity float64
+
+	// MaxResults limits the number of results. Zero returns all matches.
+	MaxResults int
+
+	// IgnoreCase compares texts case-insensitively.
+	IgnoreCase bool
+
+	// IgnorePunctuation removes punctuation and symbols before comparing texts.
+	IgnorePunctuation bool
+
+	// IgnoreDiacritics replaces Latin letters with diacritics by their base letters before comparing texts.
+	IgnoreDiacritics bool
+
+	// IgnoreWhitespace removes all whitespace before comparing texts. Otherwise consecutive
+	// whitespace is collapsed into a single space.
+	IgnoreWhitespace bool
+
+	// Partial matches the query against the best matching run of consecutive words of a text,
+	// e.g. to find "Invoice Number" in the line "Invoice Number: 1234".
+	Partial bool
+}
+
+// SearchResult represents an element matching a fuzzy search query.
+type SearchResult[T any] struct {
+	element    T
+	text       string
+	similarity float64
+}
+
+// Element returns the matching element.
+func (sr *SearchResult[T]) Element() T {
+	return sr.element
+}
+
+// Text returns the text of the element the query was compared with.
+func (sr *SearchResult[T]) Text() string {
+	return sr.text
+}
+
+// Similarity returns the similarity between the query and the text of the element,
+// where 1 is an exact match after normalization.
+func (sr *SearchResult[T]) Similarity() float64 {
+	return sr.similarity
+}
+
+// SearchKeys returns the key-value pairs whose key matches the query, ranked by similarity.
+func (p *Page) SearchKeys(query string, optFns ...func(*SearchOptions)) []*SearchResult[*KeyValue] {
+	return search(query, p.keyValues, func(kv *KeyValue) string {
+		if kv.Key() == nil {
+			return ""
+		}
+
+		return kv.Key().Text()
+	}, optFns...)
+}
+
+// SearchLines returns the lines matching the query, ranked by similarity.
+func (p *Page) SearchLines(query string, optFns ...func(*SearchOptions)) []*SearchResult[*Line] {
+	return search(query, p.lines, func(l *Line) string {
+		return l.Text()
+	}, optFns...)
+}
+
+// SearchWords returns the words matching the query, ranked by similarity.
+func (p *Page) SearchWords(query string, optFns ...func(*SearchOptions)) []*SearchResult[*Word] {
+	return search(query, p.words, (*Word).Text, optFns...)
+}
+
+// SearchKeys returns the key-value pairs of all pages whose key matches the query, ranked by similarity.
+func (d *Document) SearchKeys(query string, optFns ...func(*SearchOptions)) []*SearchResult[*KeyValue] {
+	return searchPages(d.pages, func(p *Page) []*SearchResult[*KeyValue] {
+		return p.SearchKeys(query, optFns...)
+	}, optFns...)
+}
+
+// SearchLines returns the lines of all pages matching the query, ranked by similarity.
+func (d *Document) SearchLines(query string, optFns ...func(*SearchOptions)) []*SearchResult[*Line] {
+	return searchPages(d.pages, func(p *Page) []*SearchResult[*Line] {
+		return p.SearchLines(query, optFns...)
+	}, optFns...)
+}
+
+// SearchWords returns the words of all pages matching the query, ranked by similarity.
+func (d *Document) SearchWords(query string, optFns ...func(*SearchOptions)) []*SearchResult[*Word] {
+	return searchPages(d.pages, func(p *Page) []*SearchResult[*Word] {
+		return p.SearchWords(query, optFns...)
+	}, optFns...)
+}
+
+// newSearchOptions returns the search options with defaults applied.
+func newSearchOptions(optFns ...func(*SearchOptions)) SearchOptions {
+	opts := SearchOptions{
+		MinSimilarity:     0.8,
+		MaxResults:        0,
+		IgnoreCase:        true,
+		IgnorePunctuation: true,
+		IgnoreDiacritics:  true,
+		IgnoreWhitespace:  false,
+		Partial:           false,
+	}
+
+	for _, fn := range optFns {
+		fn(&opts)
+	}
+
+	return opts
+}
+
+// search compares the query with the texts of the elements and returns the ranked matches.
+func search[T any](query string, elements []T, text func(T) string, optFns ...func(*SearchOptions)) []*SearchResult[T] {
+	opts := newSearchOptions(optFns...)
+
+	q := opts.normalize(query)
+	if q == "" {
+		return nil
+	}
+
+	var results []*SearchResult[T]
+
+	for _, e := range elements {
+		t := text(e)
+
+		if similarity := opts.similarity(q, t); similarity >= opts.MinSimilarity {
+			results = append(results, &SearchResult[T]{
+				element:    e,
+				text:       t,
+				similarity: similarity,
+			})
+		}
+	}
+
+	return rankSearchResults(results, opts.MaxResults)
+}
+
+// searchPages searches all pages and returns the ranked matches. Matches with the same similarity keep the page order.
+func searchPages[T any](pages []*Page, fn func(p *Page) []*SearchResult[T], optFns ...func(*SearchOptions)) []*SearchResult[T] {
+	opts := newSearchOptions(optFns...)
+
+	var results []*SearchResult[T]
+	for _, p := range pages {
+		results = append(results, fn(p)...)
+	}
+
+	return rankSearchResults(results, opts.MaxResults)
+}
+
+// rankSearchResults sorts the results by descending similarity, keeping the original order of equal
+// results, and limits their number.
+func rankSearchResults[T any](results []*SearchResult[T], maxResults int) []*SearchResult[T] {
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].similarity > results[j].similarity
+	})
+
+	if maxResults > 0 && len(results) > maxResults {
+		results = results[:maxResults]
+	}
+
+	return results
+}
+
+// similarity returns the similarity between the normalized query and the text. For partial
+// matching, the best similarity of all runs of consecutive words around the length of the query is used.
+func (o *SearchOptions) similarity(query, text string) float64 {
+	t := o.normalize(text)
+
+	best := levenshteinSimilarity(o.compact(query), o.compact(t))
+
+	if !o.Partial {
+		return best
+	}
+
+	queryWords := len(strings.Fields(query))
+	words := strings.Fields(t)
+
+	for n := max(queryWords-1, 1); n <= queryWords+1; n++ {
+		for i := 0; i+n <= len(words); i++ {
+			if s := levenshteinSimilarity(o.compact(query), o.compact(strings.Join(words[i:i+n], " "))); s > best {
+				best = s
+			}
+		}
+	}
+
+	return best
+}
+
+// normalize applies the case, punctuation and diacritics options to the text and collapses whitespace.
+func (o *SearchOptions) normalize(text string) string {
+	if o.IgnoreDiacritics {
+		text = internal.RemoveDiacritics(text)
+	}
+
+	if o.IgnoreCase {
+		text = strings.ToLower(text)
+	}
+
+	if o.IgnorePunctuation {
+		text = strings.Map(func(r rune) rune {
+			if unicode.IsPunct(r) || unicode.IsSymbol(r) {
+				return ' '
+			}
+
+			return r
+		}, text)
+	}
+
+	return strings.Join(strings.Fields(text), " ")
+}
+
+// compact removes the whitespace of a normalized text if whitespace is ignored.
+func (o *SearchOptions) compact(text string) string {
+	if o.IgnoreWhitespace {
+		return strings.ReplaceAll(text, " ", "")
+	}
+
+	return text
+}
+
+// levenshteinSimilarity returns 1 minus the Levenshtein distance relative to the length of the longer text.
+func levenshteinSimilarity(a, b string) float64 {
+	length := max(utf8.RuneCountInString(a), utf8.RuneCountInString(b))
+	if length == 0 {
+		return 1
+	}
+
+	return 1 - float64(internal.ComputeLevenshteinDistance(a, b))/float64(length)
+}
@@ -0,0 +1,99 @@
+package textractor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearch(t *testing.T) {
+	res, err := loadDocumentAPIOutputTestdata("testdata/test-response.json")
+	assert.NoError(t, err)
+
+	doc, err := ParseDocumentAPIOutput(res)
+	assert.NoError(t, err)
+
+	page := doc.Pages()[0]
+
+	t.Run("SearchKeys", func(t *testing.T) {
+		results := page.SearchKeys("Phone Numbr")
+		assert.Len(t, results, 1)
+		assert.Equal(t, "Phone Number:", results[0].Text())
+		assert.Equal(t, "555-0100", results[0].Element().Value().Text())
+		assert.InDelta(t, 1-1.0/12, results[0].Similarity(), 1e-9)
+
+		assert.Empty(t, page.SearchKeys("Date of Birth"))
+		assert.Empty(t, page.SearchKeys(" : "))
+	})
+
+	t.Run("Ranking", func(t *testing.T) {
+		results := page.SearchKeys("Home Adress", func(o *SearchOptions) {
+			o.MinSimilarity = 0.5
+		})
+		assert.Equal(t, "Home Address:", results[0].Text())
+
+		for i := 1; i < len(results); i++ {
+			assert.GreaterOrEqual(t, results[i-1].Similarity(), results[i].Similarity())
+		}
+
+		results = page.SearchKeys("Address", func(o *SearchOptions) {
+			o.Partial = true
+			o.MaxResults = 1
+		})
+		assert.Len(t, results, 1)
+		assert.Equal(t, "Home Address:", results[0].Text())
+		assert.Equal(t, 1.0, results[0].Similarity())
+	})
+
+	t.Run("SearchLines", func(t *testing.T) {
+		results := page.SearchLines("Aplicant Information")
+		assert.Len(t, results, 1)
+		assert.Equal(t, "Applicant iInformation", results[0].Text())
+
+		assert.Empty(t, page.SearchLines("Jane Doe"))
+
+		results = page.SearchLines("Jane Do", func(o *SearchOptions) {
+			o.Partial = true
+		})
+		assert.Len(t, results, 1)
+		assert.Equal(t, "Full Name: Jane Doe", results[0].Text())
+	})
+
+	t.Run("Normalization", func(t *testing.T) {
+		results := page.SearchWords("ÉMPLOYER")
+		assert.Len(t, results, 2)
+		assert.ElementsMatch(t, []string{"Employer", "employer"}, []string{results[0].Text(), results[1].Text()})
+		assert.Equal(t, 1.0, results[1].Similarity())
+
+		assert.Empty(t, page.SearchWords("ÉMPLOYER", func(o *SearchOptions) {
+			o.IgnoreCase = false
+		}))
+
+		assert.Empty(t, page.SearchWords("Émployer", func(o *SearchOptions) {
+			o.IgnoreDiacritics = false
+			o.MinSimilarity = 0.9
+		}))
+
+		keyResults := page.SearchKeys("FullName", func(o *SearchOptions) {
+			o.IgnoreWhitespace = true
+		})
+		assert.Len(t, keyResults, 1)
+		assert.Equal(t, 1.0, keyResults[0].Similarity())
+	})
+
+	t.Run("Document", func(t *testing.T) {
+		res, err := loadDocumentAPIOutputTestdata("testdata/test-document.json")
+		assert.NoError(t, err)
+
+		doc, err := ParseDocumentAPIOutput(res)
+		assert.NoError(t, err)
+
+		results := doc.SearchKeys("Nme of package")
+		assert.Len(t, results, 1)
+		assert.Equal(t, "Textractor", results[0].Element().Value().Text())
+
+		assert.Len(t, doc.SearchWords("Cell", func(o *SearchOptions) {
+			o.MaxResults = 2
+		}), 2)
+	})
+}
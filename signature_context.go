@@ -0,0 +1,290 @@
+package textractor
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/hupe1980/go-textractor/internal"
+)
+
+// SignatureContextOptions defines how the evidence around a signature is collected.
+type SignatureContextOptions struct {
+	// MaxDistance is the maximum distance, in normalized page coordinates, of label and name lines to the signature.
+	MaxDistance float64
+
+	// MaxDateDistance is the maximum distance, in normalized page coordinates, of date fields to the signature.
+	MaxDateDistance float64
+
+	// MinOverlap is the minimum fraction of the signature area that must lie within a key-value pair
+	// or table cell for the signature to be contained in it.
+	MinOverlap float64
+
+	// LabelKeywords are case- and diacritics-insensitive words that mark a line as signature label,
+	// e.g. "Signature of applicant".
+	LabelKeywords []string
+
+	// NormalizeOptions are the options used to detect date values.
+	NormalizeOptions NormalizeOptions
+}
+
+// SignatureEvidence represents an element related to a signature.
+type SignatureEvidence[T any] struct {
+	element    T
+	distance   float64
+	confidence float64
+}
+
+// Element returns the related element.
+func (se *SignatureEvidence[T]) Element() T {
+	return se.element
+}
+
+// Distance returns the distance between the element and the signature in normalized page coordinates.
+// It is zero if the element overlaps the signature.
+func (se *SignatureEvidence[T]) Distance() float64 {
+	return se.distance
+}
+
+// Confidence returns the confidence between 0 and 1 that the element relates to the signature. It
+// combines the distance, or overlap, with the confidence of the element.
+func (se *SignatureEvidence[T]) Confidence() float64 {
+	return se.confidence
+}
+
+// SignatureContext represents the contextual evidence of a signature.
+type SignatureContext struct {
+	signature *Signature
+	label     *SignatureEvidence[*Line]
+	name      *SignatureEvidence[*Line]
+	dates     []*SignatureEvidence[*KeyValue]
+	keyValue  *SignatureEvidence[*KeyValue]
+	tableCell *SignatureEvidence[*TableCell]
+}
+
+// Signature returns the signature.
+func (sc *SignatureContext) Signature() *Signature {
+	return sc.signature
+}
+
+// Label returns the nearest label line, e.g. "Signature of applicant", or nil if there is none.
+func (sc *SignatureContext) Label() *SignatureEvidence[*Line] {
+	return sc.label
+}
+
+// Name returns the line with the printed or signed name, or nil if there is none.
+func (sc *SignatureContext) Name() *SignatureEvidence[*Line] {
+	return sc.name
+}
+
+// Dates returns the key-value pairs with a date value near the signature, ordered by distance.
+func (sc *SignatureContext) Dates() []*SignatureEvidence[*KeyValue] {
+	return sc.dates
+}
+
+// KeyValue returns the key-value pair containing the signature, or nil if there is none.
+func (sc *SignatureContext) KeyValue() *SignatureEvidence[*KeyValue] {
+	return sc.keyValue
+}
+
+// TableCell returns the table cell containing the signature, or nil if there is none.
+func (sc *SignatureContext) TableCell() *SignatureEvidence[*TableCell] {
+	return sc.tableCell
+}
+
+// SignatureContexts returns the contexts of all signatures of the page.
+func (p *Page) SignatureContexts(optFns ...func(*SignatureContextOptions)) []*SignatureContext {
+	contexts := make([]*SignatureContext, len(p.signatures))
+	for i, s := range p.signatures {
+		contexts[i] = s.Context(optFns...)
+	}
+
+	return contexts
+}
+
+// Context collects the evidence around the signature on its page: the nearest label line, the line
+// with the printed or signed name, nearby date fields and the containing key-value pair or table cell.
+func (s *Signature) Context(optFns ...func(*SignatureContextOptions)) *SignatureContext {
+	opts := SignatureContextOptions{
+		MaxDistance:      0.05,
+		MaxDateDistance:  0.4,
+		MinOverlap:       0.5,
+		LabelKeywords:    []string{"signature", "signed", "sign here", "signatory", "unterschrift", "unterzeichnet", "firma", "signe"},
+		NormalizeOptions: NormalizeOptions{},
+	}
+
+	for _, fn := range optFns {
+		fn(&opts)
+	}
+
+	sc := &SignatureContext{
+		signature: s,
+	}
+
+	bb := s.BoundingBox()
+	if bb == nil || s.page == nil {
+		return sc
+	}
+
+	isLabel := func(text string) bool {
+		text = " " + strings.ToLower(internal.RemoveDiacritics(text))
+
+		for _, kw := range opts.LabelKeywords {
+			if strings.Contains(text, " "+strings.ToLower(internal.RemoveDiacritics(kw))) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	normalizeDate := func(text string) *TypedValue {
+		return normalize(text, nil, func(o *NormalizeOptions) {
+			*o = opts.NormalizeOptions
+			o.Types = []TypedValueType{TypedValueTypeDate}
+		})
+	}
+
+	// Lines overlapping the signature are the signed name; otherwise the nearest name-like line below or right of it
+	var nameCandidates []*SignatureEvidence[*Line]
+
+	for _, l := range s.page.lines {
+		lbb := l.BoundingBox()
+		if lbb == nil {
+			continue
+		}
+
+		d := bb.Distance(lbb)
+		if d > opts.MaxDistance {
+			continue
+		}
+
+		if isLabel(l.Text()) {
+			if c := distanceConfidence(d, opts.MaxDistance, l.Confidence()); sc.label == nil || d < sc.label.distance {
+				sc.label = &SignatureEvidence[*Line]{element: l, distance: d, confidence: c}
+			}
+
+			continue
+		}
+
+		if normalizeDate(l.Text()).Type() == TypedValueTypeDate {
+			continue
+		}
+
+		if is := bb.Intersection(lbb); is != nil {
+			nameCandidates = append(nameCandidates, &SignatureEvidence[*Line]{
+				element:    l,
+				distance:   0,
+				confidence: is.Area() / lbb.Area() * l.Confidence() / 100,
+			})
+
+			continue
+		}
+
+		if isNameLike(l.Text()) && (lbb.Top() >= bb.Bottom()-spatialEpsilon || lbb.Left() >= bb.Right()-spatialEpsilon) {
+			nameCandidates = append(nameCandidates, &SignatureEvidence[*Line]{
+				element:    l,
+				distance:   d,
+				confidence: 0.8 * distanceConfidence(d, opts.MaxDistance, l.Confidence()),
+			})
+		}
+	}
+
+	sort.SliceStable(nameCandidates, func(i, j int) bool {
+		if nameCandidates[i].distance != nameCandidates[j].distance {
+			return nameCandidates[i].distance < nameCandidates[j].distance
+		}
+
+		return nameCandidates[i].confidence > nameCandidates[j].confidence
+	})
+
+	if len(nameCandidates) > 0 {
+		sc.name = nameCandidates[0]
+	}
+
+	for _, kv := range s.page.keyValues {
+		kvbb := kv.BoundingBox()
+		if kvbb == nil {
+			continue
+		}
+
+		if is := bb.Intersection(kvbb); is != nil && is.Area() >= opts.MinOverlap*bb.Area() {
+			if c := is.Area() / bb.Area() * kv.Confidence() / 100; sc.keyValue == nil || c > sc.keyValue.confidence {
+				sc.keyValue = &SignatureEvidence[*KeyValue]{element: kv, distance: 0, confidence: c}
+			}
+		}
+
+		if kv.Value() == nil {
+			continue
+		}
+
+		d := bb.Distance(kvbb)
+		if d > opts.MaxDateDistance {
+			continue
+		}
+
+		if tv := normalizeDate(kv.Value().Text()); tv.Type() == TypedValueTypeDate {
+			sc.dates = append(sc.dates, &SignatureEvidence[*KeyValue]{
+				element:    kv,
+				distance:   d,
+				confidence: distanceConfidence(d, opts.MaxDateDistance, kv.Confidence()) * tv.Confidence(),
+			})
+		}
+	}
+
+	sort.SliceStable(sc.dates, func(i, j int) bool {
+		return sc.dates[i].distance < sc.dates[j].distance
+	})
+
+	for _, t := range s.page.tables {
+		if t.BoundingBox() == nil || t.BoundingBox().Intersection(bb) == nil {
+			continue
+		}
+
+		for _, c := range t.cells {
+			cbb := c.BoundingBox()
+			if cbb == nil {
+				continue
+			}
+
+			if is := bb.Intersection(cbb); is != nil && is.Area() >= opts.MinOverlap*bb.Area() {
+				if conf := is.Area() / bb.Area() * c.Confidence() / 100; sc.tableCell == nil || conf > sc.tableCell.confidence {
+					sc.tableCell = &SignatureEvidence[*TableCell]{element: c, distance: 0, confidence: conf}
+				}
+			}
+		}
+	}
+
+	return sc
+}
+
+// distanceConfidence scales the confidence of an element, between 0 and 100, by its distance relative to the
+// maximum distance.
+func distanceConfidence(distance, maxDistance, confidence float64) float64 {
+	if maxDistance <= 0 {
+		return confidence / 100
+	}
+
+	return (1 - distance/maxDistance) * confidence / 100
+}
+
+// isNameLike checks if the text consists of two to four capitalized words of letters, e.g. "Jane Doe".
+func isNameLike(text string) bool {
+	words := strings.Fields(text)
+	if len(words) < 2 || len(words) > 4 {
+		return false
+	}
+
+	for _, w := range words {
+		for i, r := range w {
+			switch {
+			case i == 0 && !unicode.IsUpper(r):
+				return false
+			case !unicode.IsLetter(r) && !strings.ContainsRune(".'-", r):
+				return false
+			}
+		}
+	}
+
+	return true
+}
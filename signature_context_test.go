@@ -0,0 +1,73 @@
+package textractor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSignatureContext(t *testing.T) {
+	res, err := loadDocumentAPIOutputTestdata("testdata/test-response-for-llm.json")
+	assert.NoError(t, err)
+
+	doc, err := ParseDocumentAPIOutput(res)
+	assert.NoError(t, err)
+
+	contexts := doc.Pages()[0].SignatureContexts()
+	assert.Len(t, contexts, 3)
+
+	t.Run("Lender", func(t *testing.T) {
+		sc := contexts[0]
+		assert.Equal(t, doc.Pages()[0].Signatures()[0], sc.Signature())
+
+		assert.Equal(t, "3. Signature of Lender", sc.Label().Element().Text())
+		assert.Greater(t, sc.Label().Distance(), 0.0)
+		assert.Greater(t, sc.Label().Confidence(), 0.9)
+
+		assert.Equal(t, "Carlos Salazar", sc.Name().Element().Text())
+		assert.Equal(t, 0.0, sc.Name().Distance())
+
+		assert.Equal(t, "3. Signature of Lender", sc.KeyValue().Element().Key().Text())
+		assert.Greater(t, sc.KeyValue().Confidence(), 0.5)
+
+		dates := make([]string, len(sc.Dates()))
+		for i, d := range sc.Dates() {
+			dates[i] = d.Element().Value().Text()
+		}
+
+		assert.Contains(t, dates, "12/12/2006")
+		assert.NotContains(t, dates, "08/08/2007")
+
+		for i := 1; i < len(sc.Dates()); i++ {
+			assert.LessOrEqual(t, sc.Dates()[i-1].Distance(), sc.Dates()[i].Distance())
+		}
+
+		assert.Nil(t, sc.TableCell())
+	})
+
+	t.Run("Employer", func(t *testing.T) {
+		sc := contexts[2]
+
+		// "29. Print or type name signed in Item 26" is a label as well, but further away
+		assert.Equal(t, "26. Signature of Employer", sc.Label().Element().Text())
+		assert.Equal(t, "Richard Roe", sc.Name().Element().Text())
+	})
+
+	t.Run("Options", func(t *testing.T) {
+		sc := doc.Pages()[0].Signatures()[0].Context(func(o *SignatureContextOptions) {
+			o.LabelKeywords = []string{"unterschrift"}
+			o.MaxDateDistance = 0
+		})
+
+		assert.Nil(t, sc.Label())
+		assert.Empty(t, sc.Dates())
+	})
+
+	t.Run("NameLike", func(t *testing.T) {
+		assert.True(t, isNameLike("Jane Doe"))
+		assert.True(t, isNameLike("Mary-Ann O'Brien"))
+		assert.False(t, isNameLike("Jane"))
+		assert.False(t, isNameLike("jane doe"))
+		assert.False(t, isNameLike("Item 26"))
+	})
+}
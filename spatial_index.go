@@ -0,0 +1,281 @@
+package textractor
+
+import (
+	"math"
+	"sort"
+)
+
+// spatialEpsilon is the tolerance used when comparing coordinates.
+const spatialEpsilon = 1e-9
+
+// SpatialElement represents an element that can be located on a page.
+type SpatialElement interface {
+	ID() string
+	BoundingBox() *BoundingBox
+}
+
+// Compile time check to ensure the page elements satisfy the SpatialElement interface.
+var (
+	_ SpatialElement = (*Word)(nil)
+	_ SpatialElement = (*Line)(nil)
+	_ SpatialElement = (*Table)(nil)
+	_ SpatialElement = (*KeyValue)(nil)
+	_ SpatialElement = (*Signature)(nil)
+)
+
+// SpatialIndexOptions defines the options of a spatial index.
+type SpatialIndexOptions struct {
+	// GridSize is the number of grid cells per axis the page is divided into.
+	GridSize int
+
+	// RowOverlapRatio is the minimum vertical overlap, relative to the height of the smaller element,
+	// for two elements to be on the same row.
+	RowOverlapRatio float64
+}
+
+// SpatialIndex is a grid based index to query elements by their position on a page.
+type SpatialIndex[T SpatialElement] struct {
+	opts    SpatialIndexOptions
+	entries []spatialEntry[T]
+	cells   [][]int
+}
+
+// spatialEntry is an element of the index together with its bounding box.
+type spatialEntry[T SpatialElement] struct {
+	element     T
+	boundingBox *BoundingBox
+}
+
+// NewSpatialIndex creates a new SpatialIndex instance for the given elements. Elements without
+// a bounding box are ignored.
+func NewSpatialIndex[T SpatialElement](elements []T, optFns ...func(*SpatialIndexOptions)) *SpatialIndex[T] {
+	opts := SpatialIndexOptions{
+		GridSize:        20,
+		RowOverlapRatio: 0.5,
+	}
+
+	for _, fn := range optFns {
+		fn(&opts)
+	}
+
+	opts.GridSize = max(opts.GridSize, 1)
+
+	idx := &SpatialIndex[T]{
+		opts:    opts,
+		entries: make([]spatialEntry[T], 0, len(elements)),
+		cells:   make([][]int, opts.GridSize*opts.GridSize),
+	}
+
+	for _, e := range elements {
+		bb := e.BoundingBox()
+		if bb == nil {
+			continue
+		}
+
+		i := len(idx.entries)
+		idx.entries = append(idx.entries, spatialEntry[T]{element: e, boundingBox: bb})
+
+		idx.forEachCell(bb.Left(), bb.Top(), bb.Right(), bb.Bottom(), func(c int) {
+			idx.cells[c] = append(idx.cells[c], i)
+		})
+	}
+
+	return idx
+}
+
+// SpatialIndex creates a spatial index of the words, lines, tables, key-value pairs and signatures of the page.
+func (p *Page) SpatialIndex(optFns ...func(*SpatialIndexOptions)) *SpatialIndex[SpatialElement] {
+	elements := make([]SpatialElement, 0, len(p.words)+len(p.lines)+len(p.tables)+len(p.keyValues)+len(p.signatures))
+
+	for _, w := range p.words {
+		elements = append(elements, w)
+	}
+
+	for _, l := range p.lines {
+		elements = append(elements, l)
+	}
+
+	for _, t := range p.tables {
+		elements = append(elements, t)
+	}
+
+	for _, kv := range p.keyValues {
+		elements = append(elements, kv)
+	}
+
+	for _, s := range p.signatures {
+		elements = append(elements, s)
+	}
+
+	return NewSpatialIndex(elements, optFns...)
+}
+
+// Len returns the number of indexed elements.
+func (idx *SpatialIndex[T]) Len() int {
+	return len(idx.entries)
+}
+
+// Within returns the elements that lie completely inside the bounding box, in insertion order.
+func (idx *SpatialIndex[T]) Within(bb *BoundingBox) []T {
+	return idx.query(bb.Left(), bb.Top(), bb.Right(), bb.Bottom(), func(e *BoundingBox) bool {
+		return e.Left() >= bb.Left()-spatialEpsilon && e.Right() <= bb.Right()+spatialEpsilon &&
+			e.Top() >= bb.Top()-spatialEpsilon && e.Bottom() <= bb.Bottom()+spatialEpsilon
+	})
+}
+
+// Intersecting returns the elements that overlap the bounding box, in insertion order.
+func (idx *SpatialIndex[T]) Intersecting(bb *BoundingBox) []T {
+	return idx.query(bb.Left(), bb.Top(), bb.Right(), bb.Bottom(), func(e *BoundingBox) bool {
+		return e.Intersection(bb) != nil
+	})
+}
+
+// Nearest returns the closest element in the given direction of the bounding box. Only elements
+// that lie completely beyond the edge of the bounding box and overlap it on the perpendicular axis
+// are considered, e.g. the value to the right of a label. Ties are broken by the distance of the centers.
+func (idx *SpatialIndex[T]) Nearest(bb *BoundingBox, direction Direction) (T, bool) {
+	var (
+		left, top, right, bottom = bb.Left(), bb.Top(), bb.Right(), bb.Bottom()
+		gap                      func(e *BoundingBox) float64
+		offset                   func(e *BoundingBox) float64
+	)
+
+	horizontalOffset := func(e *BoundingBox) float64 { return math.Abs(e.HorizontalCenter() - bb.HorizontalCenter()) }
+	verticalOffset := func(e *BoundingBox) float64 { return math.Abs(e.VerticalCenter() - bb.VerticalCenter()) }
+
+	switch direction {
+	case DirectionRight:
+		left, right = bb.Right(), math.Inf(1)
+		gap = func(e *BoundingBox) float64 { return e.Left() - bb.Right() }
+		offset = verticalOffset
+	case DirectionLeft:
+		left, right = math.Inf(-1), bb.Left()
+		gap = func(e *BoundingBox) float64 { return bb.Left() - e.Right() }
+		offset = verticalOffset
+	case DirectionDown:
+		top, bottom = bb.Bottom(), math.Inf(1)
+		gap = func(e *BoundingBox) float64 { return e.Top() - bb.Bottom() }
+		offset = horizontalOffset
+	case DirectionUp:
+		top, bottom = math.Inf(-1), bb.Top()
+		gap = func(e *BoundingBox) float64 { return bb.Top() - e.Bottom() }
+		offset = horizontalOffset
+	default:
+		var zero T
+		return zero, false
+	}
+
+	perpendicular := func(e *BoundingBox) bool {
+		if direction == DirectionLeft || direction == DirectionRight {
+			return math.Min(e.Bottom(), bb.Bottom())-math.Max(e.Top(), bb.Top()) > spatialEpsilon
+		}
+
+		return math.Min(e.Right(), bb.Right())-math.Max(e.Left(), bb.Left()) > spatialEpsilon
+	}
+
+	var (
+		nearest    T
+		found      bool
+		bestGap    float64
+		bestOffset float64
+	)
+
+	for _, i := range idx.candidates(left, top, right, bottom) {
+		e := idx.entries[i].boundingBox
+
+		g := gap(e)
+		if g < -spatialEpsilon || !perpendicular(e) {
+			continue
+		}
+
+		o := offset(e)
+
+		if !found || g < bestGap-spatialEpsilon || (math.Abs(g-bestGap) <= spatialEpsilon && o < bestOffset) {
+			nearest, found, bestGap, bestOffset = idx.entries[i].element, true, g, o
+		}
+	}
+
+	return nearest, found
+}
+
+// SameRow returns the elements on the same visual row as the bounding box, ordered from left
+// to right. Elements overlapping the bounding box itself are included.
+func (idx *SpatialIndex[T]) SameRow(bb *BoundingBox) []T {
+	var entries []spatialEntry[T]
+
+	for _, i := range idx.candidates(math.Inf(-1), bb.Top(), math.Inf(1), bb.Bottom()) {
+		e := idx.entries[i].boundingBox
+
+		overlap := math.Min(e.Bottom(), bb.Bottom()) - math.Max(e.Top(), bb.Top())
+		if overlap > spatialEpsilon && overlap >= idx.opts.RowOverlapRatio*math.Min(e.Height(), bb.Height()) {
+			entries = append(entries, idx.entries[i])
+		}
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].boundingBox.Left() < entries[j].boundingBox.Left()
+	})
+
+	elements := make([]T, len(entries))
+	for i, e := range entries {
+		elements[i] = e.element
+	}
+
+	return elements
+}
+
+// query returns the elements of the region whose bounding box satisfies the predicate, in insertion order.
+func (idx *SpatialIndex[T]) query(left, top, right, bottom float64, fn func(e *BoundingBox) bool) []T {
+	var elements []T
+
+	for _, i := range idx.candidates(left, top, right, bottom) {
+		if fn(idx.entries[i].boundingBox) {
+			elements = append(elements, idx.entries[i].element)
+		}
+	}
+
+	return elements
+}
+
+// candidates returns the sorted indexes of the entries in the grid cells covering the region.
+func (idx *SpatialIndex[T]) candidates(left, top, right, bottom float64) []int {
+	seen := make(map[int]bool)
+
+	var indexes []int
+
+	idx.forEachCell(left, top, right, bottom, func(c int) {
+		for _, i := range idx.cells[c] {
+			if !seen[i] {
+				seen[i] = true
+
+				indexes = append(indexes, i)
+			}
+		}
+	})
+
+	sort.Ints(indexes)
+
+	return indexes
+}
+
+// forEachCell calls fn for every grid cell covering the region. Coordinates outside the page are clamped.
+func (idx *SpatialIndex[T]) forEachCell(left, top, right, bottom float64, fn func(c int)) {
+	size := idx.opts.GridSize
+
+	cell := func(v float64) int {
+		switch {
+		case math.IsInf(v, -1) || v < 0:
+			return 0
+		case math.IsInf(v, 1) || v >= 1:
+			return size - 1
+		default:
+			return int(v * float64(size))
+		}
+	}
+
+	for row := cell(top); row <= cell(bottom); row++ {
+		for col := cell(left); col <= cell(right); col++ {
+			fn(row*size + col)
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package textractor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpatialIndex(t *testing.T) {
+	newTestWord := func(text string, left, top, width, height float64) *Word {
+		return &Word{
+			base: base{
+				id:          text,
+				boundingBox: &BoundingBox{left: left, top: top, width: width, height: height},
+			},
+			text: text,
+		}
+	}
+
+	texts := func(words []*Word) []string {
+		result := make([]string, len(words))
+		for i, w := range words {
+			result[i] = w.Text()
+		}
+
+		return result
+	}
+
+	//   Name:   Jane   Doe
+	//   Date:          2024-01-01
+	//                  Signed
+	words := []*Word{
+		newTestWord("Name:", 0.1, 0.1, 0.1, 0.02),
+		newTestWord("Jane", 0.3, 0.105, 0.1, 0.02),
+		newTestWord("Doe", 0.45, 0.1, 0.1, 0.02),
+		newTestWord("Date:", 0.1, 0.2, 0.1, 0.02),
+		newTestWord("2024-01-01", 0.3, 0.2, 0.2, 0.02),
+		newTestWord("Signed", 0.3, 0.3, 0.1, 0.02),
+		{base: base{id: "no-geometry"}},
+	}
+
+	idx := NewSpatialIndex(words, func(o *SpatialIndexOptions) {
+		o.GridSize = 8
+	})
+
+	assert.Equal(t, 6, idx.Len())
+
+	t.Run("Within", func(t *testing.T) {
+		assert.Equal(t, []string{"Jane", "Doe"}, texts(idx.Within(&BoundingBox{left: 0.25, top: 0.05, width: 0.35, height: 0.1})))
+		assert.Empty(t, idx.Within(&BoundingBox{left: 0.25, top: 0.05, width: 0.12, height: 0.1}))
+		assert.Len(t, idx.Within(&BoundingBox{left: 0, top: 0, width: 1, height: 1}), 6)
+	})
+
+	t.Run("Intersecting", func(t *testing.T) {
+		assert.Equal(t, []string{"Jane"}, texts(idx.Intersecting(&BoundingBox{left: 0.25, top: 0.05, width: 0.12, height: 0.1})))
+		assert.Equal(t, []string{"Jane", "Doe"}, texts(idx.Intersecting(&BoundingBox{left: 0.25, top: 0.05, width: 0.25, height: 0.1})))
+	})
+
+	t.Run("Nearest", func(t *testing.T) {
+		name := words[0].BoundingBox()
+
+		w, ok := idx.Nearest(name, DirectionRight)
+		assert.True(t, ok)
+		assert.Equal(t, "Jane", w.Text())
+
+		w, ok = idx.Nearest(name, DirectionDown)
+		assert.True(t, ok)
+		assert.Equal(t, "Date:", w.Text())
+
+		_, ok = idx.Nearest(name, DirectionUp)
+		assert.False(t, ok)
+
+		_, ok = idx.Nearest(name, DirectionLeft)
+		assert.False(t, ok)
+
+		w, ok = idx.Nearest(words[5].BoundingBox(), DirectionUp)
+		assert.True(t, ok)
+		assert.Equal(t, "2024-01-01", w.Text())
+
+		w, ok = idx.Nearest(words[2].BoundingBox(), DirectionLeft)
+		assert.True(t, ok)
+		assert.Equal(t, "Jane", w.Text())
+	})
+
+	t.Run("SameRow", func(t *testing.T) {
+		assert.Equal(t, []string{"Name:", "Jane", "Doe"}, texts(idx.SameRow(words[1].BoundingBox())))
+		assert.Equal(t, []string{"Date:", "2024-01-01"}, texts(idx.SameRow(words[3].BoundingBox())))
+	})
+
+	t.Run("Page", func(t *testing.T) {
+		res, err := loadDocumentAPIOutputTestdata("testdata/test-response.json")
+		assert.NoError(t, err)
+
+		doc, err := ParseDocumentAPIOutput(res)
+		assert.NoError(t, err)
+
+		page := doc.Pages()[0]
+		kv := page.SearchKeys("Full Name")[0].Element()
+
+		idx := page.SpatialIndex()
+		assert.Equal(t, len(page.Words())+len(page.Lines())+len(page.Tables())+len(page.KeyValues())+len(page.Signatures()), idx.Len())
+
+		e, ok := NewSpatialIndex(page.Words()).Nearest(kv.Key().BoundingBox(), DirectionRight)
+		assert.True(t, ok)
+		assert.Equal(t, "Jane", e.Text())
+
+		assert.Contains(t, idx.Intersecting(kv.BoundingBox()), SpatialElement(kv))
+	})
+}
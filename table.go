@@ -23,6 +23,16 @@ type Table struct {
 	cells       []*TableCell
 }
 
+// Title returns the title of the table, if any.
+func (t *Table) Title() *TableTitle {
+	return t.title
+}
+
+// Footers returns the footers of the table.
+func (t *Table) Footers() []*TableFooter {
+	return t.footers
+}
+
 func (t *Table) Words() []*Word {
 	words := make([][]*Word, 0, len(t.cells))
 
@@ -42,8 +52,23 @@ func (t *Table) Text(optFns ...func(*TextLinearizationOptions)) string {
 
 	var tableText string
 
+	// Unknown formats are linearized as plaintext, see TextLinearizationOptions.Validate
 	switch opts.TableLinearizationFormat {
-	case "plaintext":
+	case "markdown":
+		tableString := &strings.Builder{}
+
+		tw := tablewriter.NewWriter(tableString)
+		tw.SetBorders(tablewriter.Border{Left: true, Top: false, Right: true, Bottom: false})
+		tw.SetCenterSeparator("|")
+
+		header, data := t.headerAndData()
+
+		tw.SetHeader(header)
+		tw.AppendBulk(data)
+		tw.Render()
+
+		tableText = tableString.String()
+	default:
 		texts := []string{}
 
 		for _, r := range t.Rows() {
@@ -61,47 +86,16 @@ func (t *Table) Text(optFns ...func(*TextLinearizationOptions)) string {
 		}
 
 		tableText = strings.Join(texts, opts.TableRowSeparator)
-	case "markdown":
-		tableString := &strings.Builder{}
-
-		tw := tablewriter.NewWriter(tableString)
-		tw.SetBorders(tablewriter.Border{Left: true, Top: false, Right: true, Bottom: false})
-		tw.SetCenterSeparator("|")
-
-		var (
-			header []string
-			data   [][]string
-		)
-
-		for i, r := range t.Rows() {
-			if i == 0 {
-				header = make([]string, 0, len(r.Cells()))
-				for _, c := range r.Cells() {
-					header = append(header, c.Text())
-				}
-			} else {
-				rowData := make([]string, 0, len(r.Cells()))
-				for _, c := range r.Cells() {
-					rowData = append(rowData, c.Text())
-				}
-
-				data = append(data, rowData)
-			}
-		}
-
-		tw.SetHeader(header)
-		tw.AppendBulk(data)
-		tw.Render()
-
-		tableText = tableString.String()
-	default:
-		panic(fmt.Sprintf("unknown table format: %s", opts.TableLinearizationFormat))
 	}
 
 	return fmt.Sprintf("%s%s%s", opts.TablePrefix, tableText, opts.TableSuffix)
 }
 
 func (t *Table) RowCount() int {
+	if len(t.cells) == 0 {
+		return 0
+	}
+
 	max := slices.MaxFunc(t.cells, func(a, b *TableCell) int {
 		return cmp.Compare(a.rowIndex+a.rowSpan-1, b.rowIndex+b.rowSpan-1)
 	})
@@ -109,6 +103,18 @@ func (t *Table) RowCount() int {
 	return max.rowIndex
 }
 
+func (t *Table) ColumnCount() int {
+	if len(t.cells) == 0 {
+		return 0
+	}
+
+	max := slices.MaxFunc(t.cells, func(a, b *TableCell) int {
+		return cmp.Compare(a.columnIndex+a.columnSpan-1, b.columnIndex+b.columnSpan-1)
+	})
+
+	return max.columnIndex + max.columnSpan - 1
+}
+
 type CellAtOptions struct {
 	IgnoreMergedCells bool
 }
@@ -179,13 +185,44 @@ func (t *Table) RowCellsAt(rowIndex int, optFns ...func(*RowCellsAtOptions)) []C
 }
 
 type TableRow struct {
+	index int
 	cells []Cell
 }
 
+// Index returns the 1-based row index of the row in the table.
+func (tr *TableRow) Index() int {
+	return tr.index
+}
+
 func (tr *TableRow) Cells() []Cell {
 	return tr.cells
 }
 
+// IsColumnHeader checks if the row contains a column header cell.
+func (tr *TableRow) IsColumnHeader() bool {
+	return slices.ContainsFunc(tr.cells, Cell.IsColumnHeader)
+}
+
+// IsTableSummary checks if the row contains a table summary cell.
+func (tr *TableRow) IsTableSummary() bool {
+	return slices.ContainsFunc(tr.cells, Cell.IsTableSummary)
+}
+
+// IsTableSectionTitle checks if the row contains a table section title cell.
+func (tr *TableRow) IsTableSectionTitle() bool {
+	return slices.ContainsFunc(tr.cells, Cell.IsTableSectionTitle)
+}
+
+// Texts returns the texts of the cells in the row.
+func (tr *TableRow) Texts() []string {
+	texts := make([]string, len(tr.cells))
+	for i, c := range tr.cells {
+		texts[i] = c.Text()
+	}
+
+	return texts
+}
+
 // OCRConfidence returns the OCR confidence for the table row.
 func (tr *TableRow) OCRConfidence() *OCRConfidence {
 	meanValues := make([]float64, 0, len(tr.cells))
@@ -224,6 +261,7 @@ func (t *Table) Rows(optFns ...func(*RowsOptions)) []*TableRow {
 
 	for i := 1; i <= rowCount; i++ {
 		rows = append(rows, &TableRow{
+			index: i,
 			cells: t.RowCellsAt(i, func(rcao *RowCellsAtOptions) {
 				rcao.IgnoreMergedCells = opts.IgnoreMergedCells
 			}),
@@ -233,34 +271,178 @@ func (t *Table) Rows(optFns ...func(*RowsOptions)) []*TableRow {
 	return rows
 }
 
-func (t *Table) ToCSV(w io.Writer) error {
-	cw := csv.NewWriter(w)
+// HeaderRows returns the rows containing column headers. Tables can have multi-row headers.
+func (t *Table) HeaderRows() []*TableRow {
+	return t.filterRows(func(r *TableRow) bool {
+		return r.IsColumnHeader()
+	})
+}
 
-	defer cw.Flush()
+// DataRows returns the rows that are neither header, summary nor section title rows.
+func (t *Table) DataRows() []*TableRow {
+	return t.filterRows(func(r *TableRow) bool {
+		return !r.IsColumnHeader() && !r.IsTableSummary() && !r.IsTableSectionTitle()
+	})
+}
+
+// SummaryRows returns the rows containing table summary cells (e.g. totals).
+func (t *Table) SummaryRows() []*TableRow {
+	return t.filterRows(func(r *TableRow) bool {
+		return !r.IsColumnHeader() && r.IsTableSummary()
+	})
+}
+
+// SectionTitleRows returns the rows containing table section titles.
+func (t *Table) SectionTitleRows() []*TableRow {
+	return t.filterRows(func(r *TableRow) bool {
+		return !r.IsColumnHeader() && r.IsTableSectionTitle()
+	})
+}
+
+// Header returns the column names of the table. The texts of multi-row headers are joined
+// per column. It returns nil if the table has no header rows.
+func (t *Table) Header() []string {
+	headerRows := t.HeaderRows()
+	if len(headerRows) == 0 {
+		return nil
+	}
+
+	columnCount := t.ColumnCount()
+	header := make([]string, columnCount)
+
+	for i := 0; i < columnCount; i++ {
+		var (
+			parts []string
+			prev  Cell
+		)
+
+		for _, r := range headerRows {
+			c := t.CellAt(r.Index(), i+1, func(cao *CellAtOptions) {
+				cao.IgnoreMergedCells = false
+			})
 
-	var (
-		header []string
-		data   [][]string
-	)
-
-	for i, r := range t.Rows(func(ro *RowsOptions) {
-		ro.IgnoreMergedCells = true
-	}) {
-		if i == 0 {
-			header = make([]string, 0, len(r.Cells()))
-			for _, c := range r.Cells() {
-				header = append(header, c.Text())
+			// Skip empty cells and merged cells spanning multiple header rows
+			if c == nil || c == prev || c.Text() == "" {
+				continue
 			}
-		} else {
-			rowData := make([]string, 0, len(r.Cells()))
-			for _, c := range r.Cells() {
-				rowData = append(rowData, c.Text())
+
+			parts = append(parts, c.Text())
+			prev = c
+		}
+
+		header[i] = strings.Join(parts, " ")
+	}
+
+	return header
+}
+
+// Records returns the data rows as maps from column names to cells. Empty column names are
+// replaced by "Column <n>", and duplicates are suffixed with their column number.
+func (t *Table) Records() []map[string]Cell {
+	header := t.Header()
+	columnCount := t.ColumnCount()
+
+	names := make([]string, columnCount)
+	seen := make(map[string]bool, columnCount)
+
+	for i := range names {
+		name := ""
+		if i < len(header) {
+			name = header[i]
+		}
+
+		switch {
+		case name == "":
+			name = fmt.Sprintf("Column %d", i+1)
+		case seen[name]:
+			name = fmt.Sprintf("%s %d", name, i+1)
+		}
+
+		seen[name] = true
+		names[i] = name
+	}
+
+	dataRows := t.DataRows()
+	records := make([]map[string]Cell, 0, len(dataRows))
+
+	for _, r := range dataRows {
+		record := make(map[string]Cell, columnCount)
+
+		for i, name := range names {
+			if c := t.CellAt(r.Index(), i+1, func(cao *CellAtOptions) {
+				cao.IgnoreMergedCells = false
+			}); c != nil {
+				record[name] = c
 			}
+		}
+
+		records = append(records, record)
+	}
+
+	return records
+}
+
+// filterRows returns the rows matching the given predicate.
+func (t *Table) filterRows(fn func(r *TableRow) bool) []*TableRow {
+	var rows []*TableRow
+
+	for _, r := range t.Rows() {
+		if fn(r) {
+			rows = append(rows, r)
+		}
+	}
+
+	return rows
+}
+
+// headerAndData returns the column names and the texts of the remaining rows. If the table has
+// no header rows, the first row is used as header.
+func (t *Table) headerAndData() ([]string, [][]string) {
+	rows := t.Rows()
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	header := t.Header()
+	if header == nil {
+		header = t.rowTexts(rows[0].Index())
+		rows = rows[1:]
+	}
+
+	data := make([][]string, 0, len(rows))
+
+	for _, r := range rows {
+		if !r.IsColumnHeader() {
+			data = append(data, t.rowTexts(r.Index()))
+		}
+	}
+
+	return header, data
+}
+
+// rowTexts returns the texts of the row with one entry per column, so that rows with merged
+// cells have the same width as the header. Merged cells repeat their text in every column they span.
+func (t *Table) rowTexts(rowIndex int) []string {
+	texts := make([]string, t.ColumnCount())
 
-			data = append(data, rowData)
+	for i := range texts {
+		if c := t.CellAt(rowIndex, i+1, func(cao *CellAtOptions) {
+			cao.IgnoreMergedCells = false
+		}); c != nil {
+			texts[i] = c.Text()
 		}
 	}
 
+	return texts
+}
+
+func (t *Table) ToCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+
+	defer cw.Flush()
+
+	header, data := t.headerAndData()
+
 	if err := cw.Write(header); err != nil {
 		return err
 	}
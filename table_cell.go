@@ -143,6 +143,7 @@ func newCell(cb types.Block, p *Page) cell {
 		columnIndex: int(aws.ToInt32(cb.ColumnIndex)),
 		rowSpan:     int(aws.ToInt32(cb.RowSpan)),
 		columnSpan:  int(aws.ToInt32(cb.ColumnSpan)),
+		entityTypes: cb.EntityTypes,
 	}
 }
 
@@ -0,0 +1,129 @@
+package textractor
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/textract/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTable(t *testing.T) {
+	res, err := loadDocumentAPIOutputTestdata("testdata/test-layout.json")
+	assert.NoError(t, err)
+
+	doc, err := ParseDocumentAPIOutput(res)
+	assert.NoError(t, err)
+
+	earnings := doc.Tables()[3]
+	deductions := doc.Tables()[5]
+
+	t.Run("EntityTypes", func(t *testing.T) {
+		assert.True(t, earnings.CellAt(1, 1).IsColumnHeader())
+		assert.False(t, earnings.CellAt(2, 1).IsColumnHeader())
+		assert.True(t, earnings.CellAt(6, 4).IsTableSummary())
+		assert.True(t, deductions.CellAt(12, 2).IsTableSectionTitle())
+	})
+
+	t.Run("Header", func(t *testing.T) {
+		assert.Equal(t, 1, len(earnings.HeaderRows()))
+		assert.Equal(t, []string{"Earnings", "rate", "hours", "this period", "year to date"}, earnings.Header())
+		assert.Nil(t, deductions.Header())
+	})
+
+	t.Run("Rows", func(t *testing.T) {
+		assert.Equal(t, 4, len(earnings.DataRows()))
+		assert.Equal(t, 1, len(earnings.SummaryRows()))
+		assert.Equal(t, 6, earnings.SummaryRows()[0].Index())
+		assert.Empty(t, earnings.SectionTitleRows())
+
+		assert.Equal(t, 1, len(deductions.SectionTitleRows()))
+		assert.Equal(t, 12, deductions.SectionTitleRows()[0].Index())
+		assert.Equal(t, 15, deductions.SummaryRows()[0].Index())
+	})
+
+	t.Run("Records", func(t *testing.T) {
+		records := earnings.Records()
+		assert.Equal(t, 4, len(records))
+		assert.Equal(t, "Regular", records[0]["Earnings"].Text())
+		assert.Equal(t, "10.00", records[0]["rate"].Text())
+		assert.Equal(t, "16,640.00", records[0]["year to date"].Text())
+		assert.Equal(t, "Tuition", records[3]["Earnings"].Text())
+	})
+
+	t.Run("RecordsWithoutHeader", func(t *testing.T) {
+		records := deductions.Records()
+		assert.Equal(t, 13, len(records))
+		assert.Contains(t, records[0], "Column 1")
+	})
+
+	t.Run("MultiRowHeader", func(t *testing.T) {
+		header1 := &TableMergedCell{cell: newTestCell(1, 1, 2, 1, "COLUMN_HEADER"), cells: []*TableCell{{cell: newTestCell(1, 1, 1, 1, "COLUMN_HEADER"), words: []*Word{{text: "Item"}}}}}
+		header2 := &TableMergedCell{cell: newTestCell(1, 2, 1, 2, "COLUMN_HEADER"), cells: []*TableCell{{cell: newTestCell(1, 2, 1, 1, "COLUMN_HEADER"), words: []*Word{{text: "Price"}}}}}
+
+		table := &Table{
+			cells: []*TableCell{
+				header1.cells[0],
+				header2.cells[0],
+				{cell: newTestCell(1, 3, 1, 1, "COLUMN_HEADER")},
+				{cell: newTestCell(2, 1, 1, 1, "COLUMN_HEADER")},
+				{cell: newTestCell(2, 2, 1, 1, "COLUMN_HEADER"), words: []*Word{{text: "net"}}},
+				{cell: newTestCell(2, 3, 1, 1, "COLUMN_HEADER"), words: []*Word{{text: "gross"}}},
+				{cell: newTestCell(3, 1, 1, 1), words: []*Word{{text: "Book"}}},
+				{cell: newTestCell(3, 2, 1, 1), words: []*Word{{text: "10"}}},
+				{cell: newTestCell(3, 3, 1, 1), words: []*Word{{text: "12"}}},
+			},
+			mergedCells: []*TableMergedCell{header1, header2},
+		}
+
+		assert.Equal(t, 2, len(table.HeaderRows()))
+		assert.Equal(t, []string{"Item", "Price net", "Price gross"}, table.Header())
+
+		records := table.Records()
+		assert.Equal(t, 1, len(records))
+		assert.Equal(t, "12", records[0]["Price gross"].Text())
+
+		sb := &strings.Builder{}
+		assert.NoError(t, table.ToCSV(sb))
+		assert.Equal(t, "Item,Price net,Price gross\nBook,10,12\n", sb.String())
+	})
+
+	t.Run("MergedDataCell", func(t *testing.T) {
+		merged := &TableMergedCell{cell: newTestCell(2, 2, 1, 2), cells: []*TableCell{{cell: newTestCell(2, 2, 1, 1), words: []*Word{{text: "n/a"}}}}}
+
+		table := &Table{
+			cells: []*TableCell{
+				{cell: newTestCell(1, 1, 1, 1, "COLUMN_HEADER"), words: []*Word{{text: "Item"}}},
+				{cell: newTestCell(1, 2, 1, 1, "COLUMN_HEADER"), words: []*Word{{text: "net"}}},
+				{cell: newTestCell(1, 3, 1, 1, "COLUMN_HEADER"), words: []*Word{{text: "gross"}}},
+				{cell: newTestCell(2, 1, 1, 1), words: []*Word{{text: "Book"}}},
+				merged.cells[0],
+				{cell: newTestCell(2, 3, 1, 1)},
+				{cell: newTestCell(3, 1, 1, 1), words: []*Word{{text: "Pen"}}},
+				{cell: newTestCell(3, 2, 1, 1), words: []*Word{{text: "1"}}},
+				{cell: newTestCell(3, 3, 1, 1), words: []*Word{{text: "2"}}},
+			},
+			mergedCells: []*TableMergedCell{merged},
+		}
+
+		sb := &strings.Builder{}
+		assert.NoError(t, table.ToCSV(sb))
+		assert.Equal(t, "Item,net,gross\nBook,n/a,n/a\nPen,1,2\n", sb.String())
+	})
+
+	t.Run("ToCSV", func(t *testing.T) {
+		sb := &strings.Builder{}
+		assert.NoError(t, earnings.ToCSV(sb))
+		assert.True(t, strings.HasPrefix(sb.String(), "Earnings,rate,hours,this period,year to date\nRegular,10.00,32.00,320.00,\"16,640.00\"\n"))
+	})
+}
+
+func newTestCell(rowIndex, columnIndex, rowSpan, columnSpan int, entityTypes ...types.EntityType) cell {
+	return cell{
+		rowIndex:    rowIndex,
+		columnIndex: columnIndex,
+		rowSpan:     rowSpan,
+		columnSpan:  columnSpan,
+		entityTypes: entityTypes,
+	}
+}
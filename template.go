@@ -0,0 +1,314 @@
+package textractor
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hupe1980/go-textractor/internal"
+)
+
+// Template describes the fields of a fixed-layout form by their position on the page.
+type Template struct {
+	// Name is the name of the template.
+	Name string
+
+	// Anchors are printed texts with a known position that are used to correct the offset
+	// of a scanned page relative to the template.
+	Anchors []TemplateAnchor
+
+	// Fields are the named regions from which values are extracted.
+	Fields []TemplateField
+}
+
+// TemplateAnchor is a printed text with a known position on the form.
+type TemplateAnchor struct {
+	// Text is the text of the anchor, e.g. a field label.
+	Text string
+
+	// BoundingBox is the expected position of the anchor in normalized page coordinates.
+	BoundingBox *BoundingBox
+}
+
+// TemplateField is a named region of the form.
+type TemplateField struct {
+	// Name is the name of the field.
+	Name string
+
+	// Region is the position of the field value in normalized page coordinates.
+	Region *BoundingBox
+}
+
+// TemplateOptions defines how a template is matched against a page.
+type TemplateOptions struct {
+	// MinOverlap is the minimum fraction of the area of a word that must lie within a field region.
+	MinOverlap float64
+
+	// SearchOptions are the options used to find the anchors on the page.
+	SearchOptions SearchOptions
+}
+
+// TemplateResult represents the fields extracted from a page with a template.
+type TemplateResult struct {
+	fields         []*TemplateFieldValue
+	offsetX        float64
+	offsetY        float64
+	matchedAnchors int
+}
+
+// Fields returns the extracted fields in template order.
+func (tr *TemplateResult) Fields() []*TemplateFieldValue {
+	return tr.fields
+}
+
+// Field returns the extracted field with the given name, or nil if the template has no such field.
+func (tr *TemplateResult) Field(name string) *TemplateFieldValue {
+	for _, f := range tr.fields {
+		if f.name == name {
+			return f
+		}
+	}
+
+	return nil
+}
+
+// Offset returns the horizontal and vertical offset of the page relative to the template,
+// derived from the matched anchors.
+func (tr *TemplateResult) Offset() (float64, float64) {
+	return tr.offsetX, tr.offsetY
+}
+
+// MatchedAnchors returns the number of anchors found on the page.
+func (tr *TemplateResult) MatchedAnchors() int {
+	return tr.matchedAnchors
+}
+
+// TemplateFieldValue represents the value of a template field.
+type TemplateFieldValue struct {
+	name   string
+	region *BoundingBox
+	words  []*Word
+}
+
+// Name returns the name of the field.
+func (fv *TemplateFieldValue) Name() string {
+	return fv.name
+}
+
+// Region returns the offset corrected region the value was extracted from.
+func (fv *TemplateFieldValue) Region() *BoundingBox {
+	return fv.region
+}
+
+// Words returns the words within the region, in reading order.
+func (fv *TemplateFieldValue) Words() []*Word {
+	return fv.words
+}
+
+// Text returns the text of the words within the region.
+func (fv *TemplateFieldValue) Text() string {
+	texts := make([]string, len(fv.words))
+	for i, w := range fv.words {
+		texts[i] = w.Text()
+	}
+
+	return strings.Join(texts, " ")
+}
+
+// OCRConfidence returns the OCR confidence of the words within the region. The confidence is zero
+// if the region contains no words.
+func (fv *TemplateFieldValue) OCRConfidence() *OCRConfidence {
+	if len(fv.words) == 0 {
+		return &OCRConfidence{}
+	}
+
+	scores := make([]float64, len(fv.words))
+	for i, w := range fv.words {
+		scores[i] = w.Confidence()
+	}
+
+	return &OCRConfidence{
+		mean: internal.Mean(scores),
+		max:  slices.Max(scores),
+		min:  slices.Min(scores),
+	}
+}
+
+// String returns the string representation of the field value.
+func (fv *TemplateFieldValue) String() string {
+	return fmt.Sprintf("%s: %s", fv.name, fv.Text())
+}
+
+// ExtractTemplate extracts the fields of the template from the words of the page. If anchors are
+// found, the field regions are shifted by the mean offset between the expected and the found anchor positions.
+func (p *Page) ExtractTemplate(tmpl *Template, optFns ...func(*TemplateOptions)) (*TemplateResult, error) {
+	opts := TemplateOptions{
+		MinOverlap:    0.5,
+		SearchOptions: newSearchOptions(),
+	}
+
+	for _, fn := range optFns {
+		fn(&opts)
+	}
+
+	if err := tmpl.validate(); err != nil {
+		return nil, err
+	}
+
+	result := &TemplateResult{
+		fields: make([]*TemplateFieldValue, 0, len(tmpl.Fields)),
+	}
+
+	for _, a := range tmpl.Anchors {
+		bb, ok := p.findAnchor(a, opts.SearchOptions)
+		if !ok {
+			continue
+		}
+
+		result.offsetX += bb.Left() - a.BoundingBox.Left()
+		result.offsetY += bb.Top() - a.BoundingBox.Top()
+		result.matchedAnchors++
+	}
+
+	if result.matchedAnchors > 0 {
+		result.offsetX /= float64(result.matchedAnchors)
+		result.offsetY /= float64(result.matchedAnchors)
+	}
+
+	idx := NewSpatialIndex(p.words)
+
+	for _, f := range tmpl.Fields {
+		region := NewBoundingBox(f.Region.Left()+result.offsetX, f.Region.Top()+result.offsetY, f.Region.Width(), f.Region.Height())
+
+		var words []*Word
+
+		for _, w := range idx.Intersecting(region) {
+			if is := w.BoundingBox().Intersection(region); is != nil && is.Area() >= opts.MinOverlap*w.BoundingBox().Area() {
+				words = append(words, w)
+			}
+		}
+
+		result.fields = append(result.fields, &TemplateFieldValue{
+			name:   f.Name,
+			region: region,
+			words:  sortWordsInReadingOrder(words),
+		})
+	}
+
+	return result, nil
+}
+
+// validate checks that all anchors and fields of the template have a position and all fields a unique name.
+func (t *Template) validate() error {
+	var errs []error
+
+	for i, a := range t.Anchors {
+		if a.Text == "" || a.BoundingBox == nil {
+			errs = append(errs, fmt.Errorf("anchor %d requires a text and a bounding box", i))
+		}
+	}
+
+	names := make(map[string]bool, len(t.Fields))
+
+	for i, f := range t.Fields {
+		if f.Name == "" || f.Region == nil {
+			errs = append(errs, fmt.Errorf("field %d requires a name and a region", i))
+			continue
+		}
+
+		if names[f.Name] {
+			errs = append(errs, fmt.Errorf("duplicate field name: %s", f.Name))
+		}
+
+		names[f.Name] = true
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid template %q: %w", t.Name, errors.Join(errs...))
+	}
+
+	return nil
+}
+
+// findAnchor returns the bounding box of the run of consecutive words within a line that matches the
+// anchor text. If the text occurs several times, the occurrence closest to the expected position is used.
+func (p *Page) findAnchor(a TemplateAnchor, opts SearchOptions) (*BoundingBox, bool) {
+	query := opts.compact(opts.normalize(a.Text))
+	if query == "" {
+		return nil, false
+	}
+
+	n := len(strings.Fields(opts.normalize(a.Text)))
+
+	var (
+		best     *BoundingBox
+		bestDist = math.Inf(1)
+	)
+
+	for _, l := range p.lines {
+		words := l.Words()
+
+		for i := 0; i+n <= len(words); i++ {
+			texts := make([]string, n)
+			for j, w := range words[i : i+n] {
+				texts[j] = w.Text()
+			}
+
+			if levenshteinSimilarity(query, opts.compact(opts.normalize(strings.Join(texts, " ")))) < opts.MinSimilarity {
+				continue
+			}
+
+			bb := NewEnclosingBoundingBox(words[i : i+n]...)
+
+			if dist := math.Hypot(bb.Left()-a.BoundingBox.Left(), bb.Top()-a.BoundingBox.Top()); dist < bestDist {
+				best, bestDist = bb, dist
+			}
+		}
+	}
+
+	return best, best != nil
+}
+
+// sortWordsInReadingOrder groups the words into rows of vertically overlapping words and orders
+// the rows from top to bottom and the words of a row from left to right.
+func sortWordsInReadingOrder(words []*Word) []*Word {
+	sorted := slices.Clone(words)
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].BoundingBox().Top() < sorted[j].BoundingBox().Top()
+	})
+
+	var (
+		rows      [][]*Word
+		rowBottom float64
+	)
+
+	for _, w := range sorted {
+		bb := w.BoundingBox()
+
+		if len(rows) == 0 || bb.VerticalCenter() > rowBottom {
+			rows = append(rows, []*Word{w})
+			rowBottom = bb.Bottom()
+
+			continue
+		}
+
+		rows[len(rows)-1] = append(rows[len(rows)-1], w)
+		rowBottom = math.Max(rowBottom, bb.Bottom())
+	}
+
+	result := make([]*Word, 0, len(words))
+
+	for _, row := range rows {
+		sort.SliceStable(row, func(i, j int) bool {
+			return row[i].BoundingBox().Left() < row[j].BoundingBox().Left()
+		})
+
+		result = append(result, row...)
+	}
+
+	return result
+}
@@ -0,0 +1,102 @@
+package textractor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTemplate(t *testing.T) {
+	res, err := loadDocumentAPIOutputTestdata("testdata/test-response.json")
+	assert.NoError(t, err)
+
+	doc, err := ParseDocumentAPIOutput(res)
+	assert.NoError(t, err)
+
+	page := doc.Pages()[0]
+
+	// The template was designed for a scan shifted by (dx, dy) relative to the page
+	dx, dy := 0.021254, 0.026399
+
+	tmpl := &Template{
+		Name: "application",
+		Anchors: []TemplateAnchor{
+			{Text: "Full Name:", BoundingBox: NewBoundingBox(0.028746+dx, 0.113601+dy, 0.11, 0.03)},
+			{Text: "Phone Number:", BoundingBox: NewBoundingBox(0.029275+dx, 0.190636+dy, 0.16, 0.03)},
+			{Text: "Date of Birth:", BoundingBox: NewBoundingBox(0.5, 0.5, 0.1, 0.03)},
+		},
+		Fields: []TemplateField{
+			{Name: "name", Region: NewBoundingBox(0.14+dx, 0.1+dy, 0.12, 0.06)},
+			{Name: "phone", Region: NewBoundingBox(0.19+dx, 0.18+dy, 0.12, 0.055)},
+			{Name: "empty", Region: NewBoundingBox(0.8+dx, 0.8+dy, 0.1, 0.1)},
+		},
+	}
+
+	t.Run("ExtractTemplate", func(t *testing.T) {
+		result, err := page.ExtractTemplate(tmpl)
+		assert.NoError(t, err)
+
+		assert.Equal(t, 2, result.MatchedAnchors())
+
+		offsetX, offsetY := result.Offset()
+		assert.InDelta(t, -dx, offsetX, 1e-6)
+		assert.InDelta(t, -dy, offsetY, 1e-6)
+
+		assert.Len(t, result.Fields(), 3)
+		assert.Equal(t, "Jane Doe", result.Field("name").Text())
+		assert.Equal(t, "555-0100", result.Field("phone").Text())
+		assert.InDelta(t, 0.14, result.Field("name").Region().Left(), 1e-6)
+
+		ocr := result.Field("name").OCRConfidence()
+		assert.GreaterOrEqual(t, ocr.Mean(), ocr.Min())
+		assert.LessOrEqual(t, ocr.Mean(), ocr.Max())
+		assert.Greater(t, ocr.Min(), 0.0)
+
+		assert.Equal(t, "", result.Field("empty").Text())
+		assert.Equal(t, 0.0, result.Field("empty").OCRConfidence().Mean())
+		assert.Nil(t, result.Field("unknown"))
+	})
+
+	t.Run("WithoutAnchors", func(t *testing.T) {
+		result, err := page.ExtractTemplate(&Template{Fields: tmpl.Fields})
+		assert.NoError(t, err)
+
+		assert.Equal(t, 0, result.MatchedAnchors())
+
+		// Without offset correction, the region only covers most of the second word
+		assert.Equal(t, "Doe", result.Field("name").Text())
+	})
+
+	t.Run("InvalidTemplate", func(t *testing.T) {
+		_, err := page.ExtractTemplate(&Template{
+			Name:    "invalid",
+			Anchors: []TemplateAnchor{{Text: "Full Name:"}},
+			Fields: []TemplateField{
+				{Name: "name", Region: NewBoundingBox(0, 0, 0.1, 0.1)},
+				{Name: "name", Region: NewBoundingBox(0, 0, 0.1, 0.1)},
+			},
+		})
+		assert.ErrorContains(t, err, "anchor 0 requires a text and a bounding box")
+		assert.ErrorContains(t, err, "duplicate field name: name")
+	})
+}
+
+func TestSortWordsInReadingOrder(t *testing.T) {
+	newTestWord := func(text string, left, top float64) *Word {
+		return &Word{base: base{boundingBox: NewBoundingBox(left, top, 0.1, 0.02)}, text: text}
+	}
+
+	words := sortWordsInReadingOrder([]*Word{
+		newTestWord("d", 0.3, 0.2),
+		newTestWord("b", 0.3, 0.101),
+		newTestWord("a", 0.1, 0.105),
+		newTestWord("c", 0.1, 0.2),
+	})
+
+	texts := make([]string, len(words))
+	for i, w := range words {
+		texts[i] = w.Text()
+	}
+
+	assert.Equal(t, []string{"a", "b", "c", "d"}, texts)
+}
@@ -1,9 +1,6 @@
 package textractor
 
 import (
-	"fmt"
-
-	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/textract/types"
 )
 
@@ -13,14 +10,31 @@ type DocumentAPIOutput struct {
 	Blocks           []types.Block           `json:"Blocks"`
 }
 
-// ParseDocumentAPIOutput parses the Textract Document API output into a Document.
-func ParseDocumentAPIOutput(output *DocumentAPIOutput) (*Document, error) {
+// ParseDocumentAPIOutput parses the Textract Document API output into a Document. Invalid blocks
+// are skipped and recorded in the ParseReport of the document, unless strict parsing is enabled.
+func ParseDocumentAPIOutput(output *DocumentAPIOutput, optFns ...func(*ParseOptions)) (*Document, error) {
+	opts := ParseOptions{}
+
+	for _, fn := range optFns {
+		fn(&opts)
+	}
+
 	parser := newBlockParser(output.Blocks)
+	parser.polygonOverlap = opts.PolygonOverlap
+	parser.concurrency = opts.Concurrency
+
+	if opts.CorrectOrientation {
+		parser.correctOrientation()
+	}
 
 	document := parser.createDocument()
 
-	if len(document.pages) != int(aws.ToInt32(output.DocumentMetadata.Pages)) {
-		return nil, fmt.Errorf("number of pages %d does not match metadata %d", len(document.pages), aws.ToInt32(output.DocumentMetadata.Pages))
+	if err := checkPageCount(len(document.pages), output.DocumentMetadata); err != nil {
+		document.report.add(err)
+	}
+
+	if opts.Strict && document.report.HasWarnings() {
+		return nil, document.report.Err()
 	}
 
 	return document, nil
@@ -33,16 +47,30 @@ type AnalyzeIDOutput struct {
 }
 
 // ParseAnalyzeIDOutput parses the Textract Analyze ID API output into a slice of IdentityDocument.
-func ParseAnalyzeIDOutput(output *AnalyzeIDOutput) ([]*IdentityDocument, error) {
+// Problems are recorded in the ParseReport shared by the identity documents, unless strict parsing
+// is enabled. Only the Strict option applies.
+func ParseAnalyzeIDOutput(output *AnalyzeIDOutput, optFns ...func(*ParseOptions)) ([]*IdentityDocument, error) {
+	opts := ParseOptions{}
+
+	for _, fn := range optFns {
+		fn(&opts)
+	}
+
+	report := &ParseReport{}
 	parsedIdentityDocuments := make([]*IdentityDocument, len(output.IdentityDocuments))
 
 	for i, d := range output.IdentityDocuments {
 		parser := newIdentityDocumentParser(d)
 		parsedIdentityDocuments[i] = parser.createIdentityDocument()
+		parsedIdentityDocuments[i].report = report
+	}
+
+	if err := checkPageCount(len(parsedIdentityDocuments), output.DocumentMetadata); err != nil {
+		report.add(err)
 	}
 
-	if len(parsedIdentityDocuments) != int(aws.ToInt32(output.DocumentMetadata.Pages)) {
-		return nil, fmt.Errorf("number of pages %d does not match metadata %d", len(parsedIdentityDocuments), aws.ToInt32(output.DocumentMetadata.Pages))
+	if opts.Strict && report.HasWarnings() {
+		return nil, report.Err()
 	}
 
 	return parsedIdentityDocuments, nil
@@ -55,17 +83,70 @@ type AnalyzeExpenseOutput struct {
 }
 
 // ParseAnalyzeExpenseOutput parses the Textract Analyze Expense API output into a slice of ExpenseDocument.
-func ParseAnalyzeExpenseOutput(output *AnalyzeExpenseOutput) ([]*ExpenseDocument, error) {
+// Problems found in the blocks of the expense documents are recorded in the ParseReport shared by the
+// expense documents, unless strict parsing is enabled. Only the Strict option applies. The number of
+// expense documents is not checked against the metadata, as an expense document may span several pages.
+func ParseAnalyzeExpenseOutput(output *AnalyzeExpenseOutput, optFns ...func(*ParseOptions)) ([]*ExpenseDocument, error) {
+	opts := ParseOptions{}
+
+	for _, fn := range optFns {
+		fn(&opts)
+	}
+
+	report := &ParseReport{}
 	parsedExpenseDocuments := make([]*ExpenseDocument, len(output.ExpenseDocuments))
 
 	for i, d := range output.ExpenseDocuments {
 		parser := newExpenseDocumentParser(d)
 		parsedExpenseDocuments[i] = parser.createExpenseDocument()
+		parsedExpenseDocuments[i].report = report
+
+		report.warnings = append(report.warnings, parsedExpenseDocuments[i].document.report.warnings...)
 	}
 
-	if len(parsedExpenseDocuments) != int(aws.ToInt32(output.DocumentMetadata.Pages)) {
-		return nil, fmt.Errorf("number of pages %d does not match metadata %d", len(parsedExpenseDocuments), aws.ToInt32(output.DocumentMetadata.Pages))
+	if opts.Strict && report.HasWarnings() {
+		return nil, report.Err()
 	}
 
 	return parsedExpenseDocuments, nil
 }
+
+// LendingAnalysisOutput represents the output of the Textract Lending APIs.
+type LendingAnalysisOutput struct {
+	DocumentMetadata *types.DocumentMetadata `json:"DocumentMetadata"`
+
+	// Results contains the per page results returned by GetLendingAnalysis.
+	Results []types.LendingResult `json:"Results"`
+
+	// Summary contains the optional summary returned by GetLendingAnalysisSummary.
+	Summary *types.LendingSummary `json:"Summary"`
+
+	// Blocks contains optional text detection blocks of the same document. If set,
+	// each lending page is linked to the corresponding parsed Page.
+	Blocks []types.Block `json:"Blocks"`
+}
+
+// ParseLendingAnalysisOutput parses the Textract Lending API output into a LendingAnalysis. Problems,
+// including those of the linked blocks, are recorded in the ParseReport of the lending analysis, unless
+// strict parsing is enabled. Only the Strict option applies.
+func ParseLendingAnalysisOutput(output *LendingAnalysisOutput, optFns ...func(*ParseOptions)) (*LendingAnalysis, error) {
+	opts := ParseOptions{}
+
+	for _, fn := range optFns {
+		fn(&opts)
+	}
+
+	parser := newLendingAnalysisParser(output)
+
+	lendingAnalysis := parser.createLendingAnalysis()
+
+	if err := checkPageCount(len(lendingAnalysis.pages), output.DocumentMetadata); err != nil {
+		lendingAnalysis.report.add(err)
+	}
+
+	if opts.Strict && lendingAnalysis.report.HasWarnings() {
+		return nil, lendingAnalysis.report.Err()
+	}
+
+	return lendingAnalysis, nil
+}
@@ -5,9 +5,12 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"slices"
 	"strings"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/textract/types"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -115,15 +118,209 @@ Lorem ipsum dolor sit amet, consetetur sadipscing elitr, sed diam nonumy eirmod
 		assert.Equal(t, 24, len(doc.Lines()))
 		assert.Equal(t, 5, len(doc.KeyValues()))
 		assert.Equal(t, 1, len(doc.Tables()))
+		assert.False(t, doc.ParseReport().HasWarnings())
+	})
+
+	newBlock := func(id string, blockType types.BlockType, text string, relationships ...types.Relationship) types.Block {
+		return types.Block{
+			Id:            aws.String(id),
+			BlockType:     blockType,
+			Text:          aws.String(text),
+			Confidence:    aws.Float32(99),
+			Geometry:      &types.Geometry{BoundingBox: &types.BoundingBox{Left: 0.1, Top: 0.1, Width: 0.2, Height: 0.05}},
+			Relationships: relationships,
+		}
+	}
+
+	child := func(ids ...string) types.Relationship {
+		return types.Relationship{Type: types.RelationshipTypeChild, Ids: ids}
+	}
+
+	invalidBlocks := []types.Block{
+		newBlock("page", types.BlockTypePage, "", child("line", "key", "no-geometry")),
+		newBlock("line", types.BlockTypeLine, "Name", child("word", "dangling")),
+		newBlock("word", types.BlockTypeWord, "Name"),
+		func() types.Block {
+			b := newBlock("key", types.BlockTypeKeyValueSet, "", child("word"))
+			b.EntityTypes = []types.EntityType{types.EntityTypeKey}
+
+			return b
+		}(),
+		{Id: aws.String("no-geometry"), BlockType: types.BlockTypeWord, Text: aws.String("lost")},
+	}
+
+	t.Run("InvalidBlocksLenient", func(t *testing.T) {
+		doc, err := ParseDocumentAPIOutput(&DocumentAPIOutput{
+			DocumentMetadata: &types.DocumentMetadata{Pages: aws.Int32(1)},
+			Blocks:           invalidBlocks,
+		})
+		assert.NoError(t, err)
+
+		assert.Equal(t, "Name", doc.Text())
+		assert.Len(t, doc.KeyValues(), 0)
+
+		warnings := doc.ParseReport().Warnings()
+		assert.Len(t, warnings, 3)
+
+		var invalidBlockErr *InvalidBlockError
+		assert.ErrorAs(t, warnings[0], &invalidBlockErr)
+		assert.Equal(t, "no-geometry", invalidBlockErr.BlockID)
+		assert.Equal(t, "missing geometry", invalidBlockErr.Reason)
+
+		var danglingErr *DanglingBlockIDError
+		assert.ErrorAs(t, warnings[1], &danglingErr)
+		assert.Equal(t, "line", danglingErr.BlockID)
+		assert.Equal(t, "dangling", danglingErr.ReferencedID)
+
+		var missingErr *MissingRelationshipError
+		assert.ErrorAs(t, warnings[2], &missingErr)
+		assert.Equal(t, "key", missingErr.BlockID)
+		assert.Equal(t, types.RelationshipTypeValue, missingErr.RelationshipType)
+		assert.EqualError(t, missingErr, `KEY_VALUE_SET block "key" has no VALUE relationship`)
+	})
+
+	t.Run("TableWithoutCells", func(t *testing.T) {
+		res, err := loadDocumentAPIOutputTestdata("testdata/test-response.json")
+		assert.NoError(t, err)
+
+		// Replace the cells of the table by a dangling reference
+		for i, b := range res.Blocks {
+			if b.BlockType == types.BlockTypeTable {
+				res.Blocks[i].Relationships = []types.Relationship{{Type: types.RelationshipTypeChild, Ids: []string{"dangling"}}}
+				break
+			}
+		}
+
+		doc, err := ParseDocumentAPIOutput(res)
+		assert.NoError(t, err)
+		assert.Len(t, doc.ParseReport().Warnings(), 1)
+
+		assert.NotPanics(t, func() {
+			_ = doc.Text()
+		})
+		assert.Equal(t, 0, doc.Tables()[0].RowCount())
+	})
+
+	t.Run("InvalidBlocksStrict", func(t *testing.T) {
+		doc, err := ParseDocumentAPIOutput(&DocumentAPIOutput{
+			DocumentMetadata: &types.DocumentMetadata{Pages: aws.Int32(1)},
+			Blocks:           invalidBlocks,
+		}, func(po *ParseOptions) {
+			po.Strict = true
+		})
+		assert.Nil(t, doc)
+
+		var danglingErr *DanglingBlockIDError
+		assert.ErrorAs(t, err, &danglingErr)
+	})
+
+	t.Run("PageCountMismatch", func(t *testing.T) {
+		output := &DocumentAPIOutput{
+			DocumentMetadata: &types.DocumentMetadata{Pages: aws.Int32(2)},
+			Blocks:           invalidBlocks[:3],
+		}
+
+		doc, err := ParseDocumentAPIOutput(output)
+		assert.NoError(t, err)
+
+		var mismatchErr *PageCountMismatchError
+		assert.ErrorAs(t, doc.ParseReport().Err(), &mismatchErr)
+
+		_, err = ParseDocumentAPIOutput(output, func(o *ParseOptions) {
+			o.Strict = true
+		})
+
+		assert.ErrorAs(t, err, &mismatchErr)
+		assert.Equal(t, 1, mismatchErr.Pages)
+		assert.Equal(t, 2, mismatchErr.MetadataPages)
+		assert.EqualError(t, mismatchErr, "number of pages 1 does not match metadata 2")
+	})
+
+	t.Run("Concurrency", func(t *testing.T) {
+		output := newMultiPageDocumentAPIOutput(t, "testdata/test-response-for-llm.json", 4)
+
+		// A dangling reference on every page, to check the order of the report
+		for i, b := range output.Blocks {
+			if b.BlockType == types.BlockTypePage {
+				output.Blocks[i+1].Relationships = append(output.Blocks[i+1].Relationships, types.Relationship{
+					Type: types.RelationshipTypeChild,
+					Ids:  []string{fmt.Sprintf("dangling-%d", aws.ToInt32(b.Page))},
+				})
+			}
+		}
+
+		sequential, err := ParseDocumentAPIOutput(output)
+		assert.NoError(t, err)
+		assert.Len(t, sequential.ParseReport().Warnings(), 4)
+
+		again, err := ParseDocumentAPIOutput(output)
+		assert.NoError(t, err)
+		assert.True(t, assert.ObjectsAreEqual(sequential, again), "parsing should be deterministic")
+
+		expected, err := json.Marshal(sequential)
+		assert.NoError(t, err)
+
+		for _, concurrency := range []int{2, 3, 8} {
+			doc, err := ParseDocumentAPIOutput(output, func(po *ParseOptions) {
+				po.Concurrency = concurrency
+			})
+			assert.NoError(t, err)
+
+			assert.True(t, assert.ObjectsAreEqual(sequential, doc), "concurrent parsing with %d workers differs", concurrency)
+
+			data, err := json.Marshal(doc)
+			assert.NoError(t, err)
+			assert.JSONEq(t, string(expected), string(data))
+			assert.Equal(t, sequential.ParseReport().Warnings(), doc.ParseReport().Warnings())
+		}
 	})
 }
 
+func BenchmarkParseDocumentAPIOutput(b *testing.B) {
+	testCases := []string{
+		"testdata/test-document.json",
+		"testdata/test-layout.json",
+		"testdata/test-response-for-llm.json",
+		"testdata/test-simple-table-layout.json",
+	}
+
+	for _, filename := range testCases {
+		res, err := loadDocumentAPIOutputTestdata(filename)
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		b.Run(strings.TrimPrefix(filename, "testdata/"), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if _, err := ParseDocumentAPIOutput(res); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+
+	output := newMultiPageDocumentAPIOutput(b, "testdata/test-response-for-llm.json", 32)
+
+	for _, concurrency := range []int{1, 2, 4, 8} {
+		b.Run(fmt.Sprintf("32-pages/concurrency-%d", concurrency), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if _, err := ParseDocumentAPIOutput(output, func(po *ParseOptions) {
+					po.Concurrency = concurrency
+				}); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
 func TestParseAnalyzeIDOutput(t *testing.T) {
 	res, err := loadAnalyzeIDOutputTestdata("testdata/test-analyze-id-response.json")
 	assert.NoError(t, err)
 
 	idocs, err := ParseAnalyzeIDOutput(res)
 	assert.NoError(t, err)
+	assert.False(t, idocs[0].ParseReport().HasWarnings())
 
 	assert.Equal(t, 1, len(idocs))
 	assert.Equal(t, 21, len(idocs[0].Fields()))
@@ -138,7 +335,120 @@ func TestParseAnalyzeExpenseOutput(t *testing.T) {
 	edocs, err := ParseAnalyzeExpenseOutput(res)
 	assert.NoError(t, err)
 
-	fmt.Println(edocs)
+	assert.Equal(t, 1, len(edocs))
+	assert.Equal(t, 1, edocs[0].Index())
+	assert.Equal(t, 25, len(edocs[0].SummaryFields()))
+
+	total := edocs[0].SummaryFieldByType(ExpenseFieldTypeTotal)
+	assert.NotNil(t, total)
+	assert.Equal(t, "TOTAL", total.Label().Text())
+	assert.Equal(t, "$1810.46", total.Value().Text())
+	assert.Equal(t, "USD", total.Currency().Code())
+	assert.Equal(t, 1, total.PageNumber())
+	assert.NotNil(t, total.Value().BoundingBox())
+
+	assert.Equal(t, 2, len(edocs[0].SummaryFieldsByType(ExpenseFieldTypeVendorName)))
+	assert.Equal(t, 2, len(edocs[0].SummaryFieldsByGroupType("VENDOR")))
+
+	assert.Equal(t, 1, len(edocs[0].LineItemGroups()))
+	assert.Equal(t, 4, len(edocs[0].LineItemGroups()[0].LineItems()))
+	assert.Equal(t, "LG FLATSCREEN 65", edocs[0].LineItemGroups()[0].LineItems()[0].FieldByType(ExpenseFieldTypeItem).Value().Text())
+	assert.Equal(t, "$899.99 S", edocs[0].LineItemGroups()[0].LineItems()[0].FieldByType(ExpenseFieldTypePrice).Value().Text())
+
+	assert.False(t, edocs[0].ParseReport().HasWarnings())
+
+	t.Run("MultiPage", func(t *testing.T) {
+		// A single invoice spanning several pages
+		res.DocumentMetadata.Pages = aws.Int32(3)
+
+		edocs, err := ParseAnalyzeExpenseOutput(res, func(o *ParseOptions) {
+			o.Strict = true
+		})
+		assert.NoError(t, err)
+		assert.False(t, edocs[0].ParseReport().HasWarnings())
+	})
+
+	t.Run("InvalidBlocks", func(t *testing.T) {
+		blocks := slices.Clone(res.ExpenseDocuments[0].Blocks)
+
+		for i, b := range blocks {
+			if b.BlockType == types.BlockTypeLine {
+				blocks[i].Relationships = []types.Relationship{{Type: types.RelationshipTypeChild, Ids: []string{"dangling"}}}
+				break
+			}
+		}
+
+		output := &AnalyzeExpenseOutput{
+			DocumentMetadata: res.DocumentMetadata,
+			ExpenseDocuments: []types.ExpenseDocument{res.ExpenseDocuments[0]},
+		}
+		output.ExpenseDocuments[0].Blocks = blocks
+
+		edocs, err := ParseAnalyzeExpenseOutput(output)
+		assert.NoError(t, err)
+
+		var danglingErr *DanglingBlockIDError
+		assert.ErrorAs(t, edocs[0].ParseReport().Err(), &danglingErr)
+
+		_, err = ParseAnalyzeExpenseOutput(output, func(o *ParseOptions) {
+			o.Strict = true
+		})
+		assert.ErrorAs(t, err, &danglingErr)
+	})
+}
+
+func TestParseLendingAnalysisOutput(t *testing.T) {
+	res, err := loadLendingAnalysisOutputTestdata("testdata/test-analyze-lending-response.json")
+	assert.NoError(t, err)
+
+	la, err := ParseLendingAnalysisOutput(res)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 3, len(la.Pages()))
+	assert.Equal(t, []string{"PAYSLIPS", "BANK_STATEMENTS"}, la.PageTypes())
+	assert.Equal(t, 2, len(la.PagesByType("PAYSLIPS")))
+	assert.Equal(t, 5, len(la.LendingFields()))
+
+	page := la.Pages()[0]
+	assert.Equal(t, 1, page.PageNumber())
+	assert.Equal(t, "PAYSLIPS", page.PageType())
+	assert.InDelta(t, 99.1, page.PageTypeConfidence(), 0.0001)
+	assert.Equal(t, "1", page.PageNumberInType().Value())
+	assert.Equal(t, "23,526.80", page.LendingFieldByType("PAYSLIP_GROSS_PAY_YTD").Value().Text())
+	assert.Equal(t, "YTD Gross", page.LendingFieldByType("PAYSLIP_GROSS_PAY_YTD").Key().Text())
+	assert.Nil(t, page.LendingFieldByType("PAYSLIP_CURRENT_GROSS_PAY").Key())
+	assert.Equal(t, 1, len(page.Signatures()))
+	assert.NotNil(t, page.Signatures()[0].BoundingBox())
+
+	assert.NotNil(t, page.Page())
+	assert.Equal(t, "ANY COMPANY CORP.", page.Page().Lines()[0].Text())
+	assert.Equal(t, la.Document().Pages()[2], la.Pages()[2].Page())
+	assert.True(t, la.Pages()[2].LendingFieldByType("BANK_STATEMENT_ACCOUNT_TYPE").Value().IsSelected())
+
+	summary := la.Summary()
+	assert.Equal(t, 2, len(summary.DocumentGroups()))
+	assert.Equal(t, []string{"W2", "1099_INT"}, summary.UndetectedDocumentTypes())
+
+	payslips := summary.DocumentGroupByType("PAYSLIPS")
+	assert.Equal(t, []int{1, 2}, payslips.SplitDocuments()[0].Pages())
+	assert.Equal(t, []int{1}, payslips.DetectedSignaturePages())
+	assert.Equal(t, []int{3}, summary.DocumentGroupByType("BANK_STATEMENTS").UndetectedSignaturePages())
+	assert.False(t, la.ParseReport().HasWarnings())
+
+	t.Run("PageCountMismatch", func(t *testing.T) {
+		res.DocumentMetadata = &types.DocumentMetadata{Pages: aws.Int32(4)}
+
+		la, err := ParseLendingAnalysisOutput(res)
+		assert.NoError(t, err)
+
+		var mismatchErr *PageCountMismatchError
+		assert.ErrorAs(t, la.ParseReport().Err(), &mismatchErr)
+
+		_, err = ParseLendingAnalysisOutput(res, func(o *ParseOptions) {
+			o.Strict = true
+		})
+		assert.ErrorAs(t, err, &mismatchErr)
+	})
 }
 
 func loadDocumentAPIOutputTestdata(filename string) (*DocumentAPIOutput, error) {
@@ -203,3 +513,24 @@ func loadAnalyzeExpenseOutputTestdata(filename string) (*AnalyzeExpenseOutput, e
 
 	return output, nil
 }
+
+func loadLendingAnalysisOutputTestdata(filename string) (*LendingAnalysisOutput, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	defer f.Close()
+
+	data, err := io.ReadAll(f)
+	if err != nil {
+		return nil, err
+	}
+
+	output := new(LendingAnalysisOutput)
+	if err := json.Unmarshal(data, output); err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}